@@ -12,6 +12,8 @@ type Arbitrary struct {
 	Elements   Arbitraries   // Arbitrary for each element in collection
 	Precursors Arbitraries   // Precursor arbitraries from which this one is generated
 	Shrinker   Shrinker
+	Table      func() FuncTable                         // Table that describes generated function, nil for other values
+	Feed       func(done <-chan struct{}) reflect.Value // Creates fresh value for each use, nil if Value can be reused
 }
 
 func (arb Arbitrary) CompareType(target Arbitrary) error {
@@ -47,9 +49,30 @@ func (arb Arbitrary) Copy() Arbitrary {
 		Elements:   elements,
 		Precursors: precursors,
 		Shrinker:   arb.Shrinker,
+		Table:      arb.Table,
+		Feed:       arb.Feed,
 	}
 }
 
+// HasTable returns true if arbitrary, or any of it's elements or precursors, is a function
+// described by Table.
+func (arb Arbitrary) HasTable() bool {
+	if arb.Table != nil {
+		return true
+	}
+	for _, element := range arb.Elements {
+		if element.HasTable() {
+			return true
+		}
+	}
+	for _, precursor := range arb.Precursors {
+		if precursor.HasTable() {
+			return true
+		}
+	}
+	return false
+}
+
 type Arbitraries []Arbitrary
 
 func (arbs Arbitraries) Values() []reflect.Value {
@@ -60,6 +83,18 @@ func (arbs Arbitraries) Values() []reflect.Value {
 	return out
 }
 
+// Feed returns values of arbitraries the same way as Values does, except that arbitraries
+// with Feed create a fresh value. Fresh values are released once "done" is closed.
+func (arbs Arbitraries) Feed(done <-chan struct{}) []reflect.Value {
+	out := arbs.Values()
+	for index, arb := range arbs {
+		if arb.Feed != nil {
+			out[index] = arb.Feed(done)
+		}
+	}
+	return out
+}
+
 func NewSlice(t reflect.Type) func(Arbitrary) Arbitrary {
 	return func(arb Arbitrary) Arbitrary {
 		arb.Value = reflect.MakeSlice(t, len(arb.Elements), len(arb.Elements))
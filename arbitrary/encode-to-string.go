@@ -9,6 +9,61 @@ import (
 
 type stringEncoder func(val reflect.Value) string
 
+// pointerKey identifies a pointer by it's type and address, as pointers of different types
+// can share the address (e.g. pointer to a struct and pointer to it's first field).
+type pointerKey struct {
+	t       reflect.Type
+	address uintptr
+}
+
+// pointerRefs holds pointers that are referenced more than once within encoded value, and
+// ids assigned to them once they are encoded, so that they can be back-referenced.
+type pointerRefs struct {
+	shared map[pointerKey]bool
+	ids    map[pointerKey]int
+}
+
+// sharedPointers returns pointers that are referenced more than once within "val", either
+// because they are aliased or because they form a cycle.
+func sharedPointers(val reflect.Value) map[pointerKey]bool {
+	visited, shared := map[pointerKey]bool{}, map[pointerKey]bool{}
+
+	var visit func(val reflect.Value)
+	visit = func(val reflect.Value) {
+		switch val.Kind() {
+		case reflect.Ptr:
+			if val.IsNil() {
+				return
+			}
+			key := pointerKey{t: val.Type(), address: val.Pointer()}
+			if visited[key] {
+				shared[key] = true
+				return
+			}
+			visited[key] = true
+			visit(val.Elem())
+		case reflect.Interface:
+			visit(val.Elem())
+		case reflect.Slice, reflect.Array:
+			for index := 0; index < val.Len(); index++ {
+				visit(val.Index(index))
+			}
+		case reflect.Map:
+			for iter := val.MapRange(); iter.Next(); {
+				visit(iter.Key())
+				visit(iter.Value())
+			}
+		case reflect.Struct:
+			for index := 0; index < val.NumField(); index++ {
+				visit(val.Field(index))
+			}
+		}
+	}
+
+	visit(val)
+	return shared
+}
+
 func (s stringEncoder) Nil() stringEncoder {
 	return func(val reflect.Value) string {
 		switch val.Kind() {
@@ -54,7 +109,7 @@ func (s stringEncoder) Map() stringEncoder {
 			// it's keys are sorted by their encoded string value
 			keys := val.MapKeys()
 			sort.SliceStable(keys, func(i1, i2 int) bool {
-				return s(keys[i1]) > s(keys[i2])
+				return encodeToString()(keys[i1]) > encodeToString()(keys[i2])
 			})
 			for index, key := range keys {
 				data[index] = fmt.Sprintf("%s: %s", s(key), s(val.MapIndex(key)))
@@ -82,8 +137,19 @@ func (s stringEncoder) Struct() stringEncoder {
 }
 
 func encodeToString() stringEncoder {
+	return encoder(&pointerRefs{})
+}
+
+// encoder returns string encoder that encodes pointers referenced more than once with
+// an id (e.g. "&1 <T> {...}") the first time they are encoded, and with a back-reference
+// to the id (e.g. "(&1)") afterwards, so that aliased pointers and cycles can be encoded.
+func encoder(refs *pointerRefs) stringEncoder {
 	return func(val reflect.Value) string {
-		s := encodeToString()
+		if refs.shared == nil {
+			refs.shared, refs.ids = sharedPointers(val), map[pointerKey]int{}
+		}
+
+		s := encoder(refs)
 		switch val.Kind() {
 		case reflect.Slice, reflect.Array:
 			return s.Nil().ArrayOrSlice()(val)
@@ -92,7 +158,18 @@ func encodeToString() stringEncoder {
 		case reflect.Struct:
 			return s.Struct()(val)
 		case reflect.Ptr:
-			return s.Nil().Type()(val.Elem())
+			if val.IsNil() {
+				return "(nil)"
+			}
+			key := pointerKey{t: val.Type(), address: val.Pointer()}
+			if !refs.shared[key] {
+				return s.Nil().Type()(val.Elem())
+			}
+			if id, exists := refs.ids[key]; exists {
+				return fmt.Sprintf("(&%d)", id)
+			}
+			refs.ids[key] = len(refs.ids) + 1
+			return fmt.Sprintf("&%d %s", refs.ids[key], s.Nil().Type()(val.Elem()))
 		case reflect.Func, reflect.Chan:
 			return fmt.Sprintf("(%#x)", val.Pointer())
 		default:
@@ -105,3 +182,76 @@ func encodeToString() stringEncoder {
 func EncodeToString(val reflect.Value) string {
 	return encodeToString().Nil().Type()(val)
 }
+
+// EncodeArbitraryToString encodes arbitrary's value to it's string representation. Unlike
+// [EncodeToString], functions described by Table of the arbitrary, or any of it's elements
+// and precursors, are encoded as their table (e.g. "<func(int) int> func{1 -> 0, _ -> -1}").
+// Elements are matched with elements of slices, arrays, structs, maps and pointers, while
+// precursor is used instead of the arbitrary if it is the first one and has the same type.
+func EncodeArbitraryToString(arb Arbitrary) string {
+	return arbitraryEncoder(arb, &pointerRefs{}).Nil().Type()(arb.Value)
+}
+
+// arbitraryEncoder returns string encoder that encodes value of the arbitrary, using
+// arbitrary's elements and precursors to encode functions described by their Table.
+// Parts of the value that can't be matched with arbitrary's elements are encoded with
+// string encoder that shares "refs".
+func arbitraryEncoder(arb Arbitrary, refs *pointerRefs) stringEncoder {
+	return func(val reflect.Value) string {
+		if refs.shared == nil {
+			refs.shared, refs.ids = sharedPointers(val), map[pointerKey]int{}
+		}
+
+		elements := func(n int) bool {
+			return len(arb.Elements) == n
+		}
+
+		switch {
+		case arb.Table != nil:
+			return arb.Table().String()
+		case !arb.HasTable():
+			return encoder(refs)(val)
+		case len(arb.Precursors) != 0 && arb.Precursors[0].Value.Type() == val.Type() && len(arb.Elements) == 0:
+			return arbitraryEncoder(arb.Precursors[0], refs)(val)
+		case (val.Kind() == reflect.Slice || val.Kind() == reflect.Array) && elements(val.Len()):
+			data := make([]string, val.Len())
+			for index := range data {
+				data[index] = arbitraryEncoder(arb.Elements[index], refs).Nil()(val.Index(index))
+			}
+			return fmt.Sprintf("[%s]", strings.Join(data, ", "))
+		case val.Kind() == reflect.Struct && elements(val.NumField()):
+			data := make([]string, val.NumField())
+			for index := range data {
+				data[index] = fmt.Sprintf("\"%s\": %s", val.Type().Field(index).Name, arbitraryEncoder(arb.Elements[index], refs).Type()(val.Field(index)))
+			}
+			return fmt.Sprintf("{%s}", strings.Join(data, ", "))
+		case val.Kind() == reflect.Map && elements(val.Len()):
+			data := make([]string, 0, val.Len())
+			entries := make([]Arbitrary, len(arb.Elements))
+			copy(entries, arb.Elements)
+
+			// Entries are sorted the same way as keys of maps encoded by EncodeToString
+			sort.SliceStable(entries, func(i1, i2 int) bool {
+				return encodeToString()(entries[i1].Elements[0].Value) > encodeToString()(entries[i2].Elements[0].Value)
+			})
+			for _, entry := range entries {
+				key, value := entry.Elements[0], entry.Elements[1]
+				data = append(data, fmt.Sprintf("%s: %s", arbitraryEncoder(key, refs).Nil()(key.Value), arbitraryEncoder(value, refs).Nil()(value.Value)))
+			}
+			return fmt.Sprintf("{%s}", strings.Join(data, ", "))
+		case val.Kind() == reflect.Ptr && !val.IsNil() && elements(1):
+			elem := arbitraryEncoder(arb.Elements[0], refs).Nil().Type()
+			key := pointerKey{t: val.Type(), address: val.Pointer()}
+			if !refs.shared[key] {
+				return elem(val.Elem())
+			}
+			if id, exists := refs.ids[key]; exists {
+				return fmt.Sprintf("(&%d)", id)
+			}
+			refs.ids[key] = len(refs.ids) + 1
+			return fmt.Sprintf("&%d %s", refs.ids[key], elem(val.Elem()))
+		default:
+			return encoder(refs)(val)
+		}
+	}
+}
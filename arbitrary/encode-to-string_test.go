@@ -0,0 +1,126 @@
+package arbitrary
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEncodeToString(t *testing.T) {
+	type node struct {
+		Value int
+		Next  *node
+	}
+
+	testCases := map[string]func(*testing.T){
+		"NilPointerField": func(t *testing.T) {
+			encoded := EncodeToString(reflect.ValueOf(&node{Value: 1}))
+			expected := `<*arbitrary.node> <arbitrary.node> {"Value": <int> 1, "Next": <*arbitrary.node> (nil)}`
+			if encoded != expected {
+				t.Fatalf("Expected: %s. Got: %s", expected, encoded)
+			}
+		},
+		"Alias": func(t *testing.T) {
+			shared := &node{Value: 2}
+			encoded := EncodeToString(reflect.ValueOf([]*node{shared, {Value: 1, Next: shared}}))
+			expected := `<[]*arbitrary.node> [&1 <arbitrary.node> {"Value": <int> 2, "Next": <*arbitrary.node> (nil)}, <arbitrary.node> {"Value": <int> 1, "Next": <*arbitrary.node> (&1)}]`
+			if encoded != expected {
+				t.Fatalf("Expected: %s. Got: %s", expected, encoded)
+			}
+		},
+		"Cycle": func(t *testing.T) {
+			first := &node{Value: 1}
+			first.Next = &node{Value: 2, Next: first}
+			encoded := EncodeToString(reflect.ValueOf(first))
+			expected := `<*arbitrary.node> &1 <arbitrary.node> {"Value": <int> 1, "Next": <*arbitrary.node> <arbitrary.node> {"Value": <int> 2, "Next": <*arbitrary.node> (&1)}}`
+			if encoded != expected {
+				t.Fatalf("Expected: %s. Got: %s", expected, encoded)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
+
+func TestEncodeArbitraryToString(t *testing.T) {
+	type record struct {
+		ID int
+		Fn func(int) int
+	}
+
+	fn := func(int) int { return 0 }
+	table := func() FuncTable {
+		return FuncTable{
+			Entries: []FuncEntry{{Inputs: []reflect.Value{reflect.ValueOf(1)}, Outputs: []reflect.Value{reflect.ValueOf(0)}}},
+			Default: []reflect.Value{reflect.ValueOf(-1)},
+		}
+	}
+
+	// function is wrapped in an arbitrary that has it as precursor (e.g. choice of functions)
+	function := Arbitrary{
+		Value:      reflect.ValueOf(fn),
+		Precursors: Arbitraries{{Value: reflect.ValueOf(fn), Table: table}},
+	}
+
+	testCases := map[string]func(*testing.T){
+		"Struct": func(t *testing.T) {
+			arb := Arbitrary{
+				Value:    reflect.ValueOf(record{ID: 1, Fn: fn}),
+				Elements: Arbitraries{{Value: reflect.ValueOf(1)}, function},
+			}
+
+			encoded := EncodeArbitraryToString(arb)
+			expected := `<arbitrary.record> {"ID": <int> 1, "Fn": <func(int) int> func{1 -> 0, _ -> -1}}`
+			if encoded != expected {
+				t.Fatalf("Expected: %s. Got: %s", expected, encoded)
+			}
+		},
+		"SliceOfPointers": func(t *testing.T) {
+			arb := Arbitrary{
+				Value: reflect.ValueOf([]*record{{ID: 1, Fn: fn}, nil}),
+				Elements: Arbitraries{
+					{Value: reflect.ValueOf(&record{}), Elements: Arbitraries{{
+						Value:    reflect.ValueOf(record{ID: 1, Fn: fn}),
+						Elements: Arbitraries{{Value: reflect.ValueOf(1)}, function},
+					}}},
+					{Value: reflect.ValueOf((*record)(nil))},
+				},
+			}
+
+			encoded := EncodeArbitraryToString(arb)
+			expected := `<[]*arbitrary.record> [<arbitrary.record> {"ID": <int> 1, "Fn": <func(int) int> func{1 -> 0, _ -> -1}}, (nil)]`
+			if encoded != expected {
+				t.Fatalf("Expected: %s. Got: %s", expected, encoded)
+			}
+		},
+		"Map": func(t *testing.T) {
+			arb := Arbitrary{
+				Value: reflect.ValueOf(map[int]func(int) int{1: fn}),
+				Elements: Arbitraries{
+					{Elements: Arbitraries{{Value: reflect.ValueOf(1)}, function}},
+				},
+			}
+
+			encoded := EncodeArbitraryToString(arb)
+			expected := `<map[int]func(int) int> {1: func{1 -> 0, _ -> -1}}`
+			if encoded != expected {
+				t.Fatalf("Expected: %s. Got: %s", expected, encoded)
+			}
+		},
+		"WithoutTable": func(t *testing.T) {
+			arb := Arbitrary{
+				Value:    reflect.ValueOf([]int{1, 2}),
+				Elements: Arbitraries{{Value: reflect.ValueOf(1)}, {Value: reflect.ValueOf(2)}},
+			}
+
+			if encoded, expected := EncodeArbitraryToString(arb), EncodeToString(arb.Value); encoded != expected {
+				t.Fatalf("Expected: %s. Got: %s", expected, encoded)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
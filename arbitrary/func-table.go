@@ -0,0 +1,90 @@
+package arbitrary
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FuncEntry is a single entry of a [FuncTable], that maps function's inputs to it's outputs.
+type FuncEntry struct {
+	Inputs  []reflect.Value
+	Outputs []reflect.Value
+}
+
+// FuncTable describes a function as a finite input→output table. Default are the outputs
+// returned for inputs that are not in the table, or nil if they are not known.
+type FuncTable struct {
+	Entries []FuncEntry
+	Default []reflect.Value
+}
+
+// String returns FuncTable's string representation in form of "func{in -> out, _ -> default}".
+// Inputs and outputs are enclosed in parentheses if there isn't exactly one of them.
+func (table FuncTable) String() string {
+	values := func(vals []reflect.Value) string {
+		data := make([]string, len(vals))
+		for index, val := range vals {
+			data[index] = encodeToString().Nil()(val)
+		}
+		if len(data) == 1 {
+			return data[0]
+		}
+		return fmt.Sprintf("(%s)", strings.Join(data, ", "))
+	}
+
+	data := make([]string, 0, len(table.Entries)+1)
+	for _, entry := range table.Entries {
+		data = append(data, fmt.Sprintf("%s -> %s", values(entry.Inputs), values(entry.Outputs)))
+	}
+	if table.Default != nil {
+		data = append(data, fmt.Sprintf("_ -> %s", values(table.Default)))
+	}
+
+	return fmt.Sprintf("func{%s}", strings.Join(data, ", "))
+}
+
+// NewFunc returns a transformation that sets arbitrary's value to a function of type "t",
+// defined by arbitrary's elements. The first element holds the table entries, each with
+// function's inputs as precursors and outputs as elements, while the second element holds
+// outputs returned for the inputs that are not in the table. Entries are matched by the
+// encoded string value of the inputs. Arbitrary's Table is set to the function's table.
+func NewFunc(t reflect.Type) func(Arbitrary) Arbitrary {
+	return func(arb Arbitrary) Arbitrary {
+		entries, fallback := arb.Elements[0].Elements, arb.Elements[1].Elements
+
+		table := FuncTable{
+			Entries: make([]FuncEntry, len(entries)),
+			Default: fallback.Values(),
+		}
+		outputs := make(map[string][]reflect.Value, len(entries))
+		for index, entry := range entries {
+			table.Entries[index] = FuncEntry{
+				Inputs:  entry.Precursors.Values(),
+				Outputs: entry.Elements.Values(),
+			}
+			outputs[funcKey(table.Entries[index].Inputs)] = table.Entries[index].Outputs
+		}
+
+		arb.Value = reflect.MakeFunc(t, func(inputs []reflect.Value) []reflect.Value {
+			if out, ok := outputs[funcKey(inputs)]; ok {
+				return out
+			}
+			return table.Default
+		})
+		arb.Table = func() FuncTable {
+			return table
+		}
+
+		return arb
+	}
+}
+
+// funcKey returns the key that identifies function's inputs.
+func funcKey(inputs []reflect.Value) string {
+	data := make([]string, len(inputs))
+	for index, input := range inputs {
+		data[index] = EncodeToString(input)
+	}
+	return strings.Join(data, ", ")
+}
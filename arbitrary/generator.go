@@ -17,25 +17,21 @@ type Generator func(target reflect.Type, bias constraints.Bias, r Random) (Arbit
 // mapper is invalid or if generator of mapper's input type returns an error.
 func (generator Generator) Map(mapper interface{}) Generator {
 	return func(target reflect.Type, bias constraints.Bias, r Random) (Arbitrary, error) {
-		val := reflect.ValueOf(mapper)
+		in, out, mapFn, err := mapperOf(mapper)
 		switch {
-		case val.Kind() != reflect.Func:
-			return Arbitrary{}, fmt.Errorf("%w. Mapper must be a function", ErrorMapper)
-		case val.Type().NumOut() != 1:
-			return Arbitrary{}, fmt.Errorf("%w. Mapper must have 1 output value", ErrorMapper)
-		case val.Type().NumIn() != 1:
-			return Arbitrary{}, fmt.Errorf("%w. Mapper must have 1 input value", ErrorMapper)
-		case val.Type().Out(0).Kind() != target.Kind():
-			return Arbitrary{}, fmt.Errorf("%w. Mappers output kind: %s must match target's kind. Got: %s", ErrorMapper, val.Type().Out(0).Kind(), target.Kind())
+		case err != nil:
+			return Arbitrary{}, fmt.Errorf("%w. %s", ErrorMapper, err)
+		case out.Kind() != target.Kind():
+			return Arbitrary{}, fmt.Errorf("%w. Mappers output kind: %s must match target's kind. Got: %s", ErrorMapper, out.Kind(), target.Kind())
 		}
 
-		arb, err := generator(val.Type().In(0), bias, r)
+		arb, err := generator(in, bias, r)
 		if err != nil {
 			return Arbitrary{}, fmt.Errorf("Failed to use base generator. %w", err)
 		}
 
 		return Arbitrary{
-			Value:      reflect.ValueOf(mapper).Call([]reflect.Value{arb.Value})[0],
+			Value:      mapFn(arb.Value),
 			Precursors: []Arbitrary{arb},
 			Shrinker:   arb.Shrinker.Map(mapper),
 		}, nil
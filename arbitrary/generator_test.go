@@ -2,6 +2,8 @@ package arbitrary_test
 
 import (
 	"errors"
+	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/steffnova/go-check/arbitrary"
@@ -80,6 +82,19 @@ func TestGeneratorMap(t *testing.T) {
 				t.Fatalf("Unexpected error: %s", err)
 			}
 		},
+		"MapperFunction": func(t *testing.T) {
+			mapper := arbitrary.Mapper(reflect.TypeOf(0), reflect.TypeOf(""), func(in reflect.Value) reflect.Value {
+				return reflect.ValueOf(strconv.Itoa(int(in.Int())))
+			})
+
+			fn, ok := mapper.(func(int) string)
+			if !ok {
+				t.Fatalf("Expected mapper to be func(int) string. Got: %T", mapper)
+			}
+			if out := fn(5); out != "5" {
+				t.Fatalf("Expected mapped value: 5. Got: %s", out)
+			}
+		},
 	}
 
 	for name, testCase := range testCases {
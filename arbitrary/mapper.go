@@ -1,6 +1,9 @@
 package arbitrary
 
-import "reflect"
+import (
+	"fmt"
+	"reflect"
+)
 
 // Mapper returns mapper function that can be used with Map combinator for generators and
 // shrinkers. Parameter "in' defines mapper's input type, parameter "out" defines mapper's
@@ -14,3 +17,23 @@ func Mapper(in, out reflect.Type, mapFn func(reflect.Value) reflect.Value) inter
 
 	return mapper.Interface()
 }
+
+// mapperOf returns input type, output type and mapping function of a mapper. Mapper is
+// a function with one input and one output value. Error is returned if mapper is invalid.
+func mapperOf(m interface{}) (reflect.Type, reflect.Type, func(reflect.Value) reflect.Value, error) {
+	val := reflect.ValueOf(m)
+	switch {
+	case val.Kind() != reflect.Func:
+		return nil, nil, nil, fmt.Errorf("Mapper must be a function")
+	case val.Type().NumOut() != 1:
+		return nil, nil, nil, fmt.Errorf("Mapper must have 1 output value")
+	case val.Type().NumIn() != 1:
+		return nil, nil, nil, fmt.Errorf("Mapper must have 1 input value")
+	}
+
+	mapFn := func(in reflect.Value) reflect.Value {
+		return val.Call([]reflect.Value{in})[0]
+	}
+
+	return val.Type().In(0), val.Type().Out(0), mapFn, nil
+}
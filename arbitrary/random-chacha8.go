@@ -0,0 +1,59 @@
+package arbitrary
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+
+	"github.com/steffnova/go-check/constraints"
+)
+
+// RandomChaCha8 is implementation of Random interface backed by [rand.ChaCha8] source
+// from math/rand/v2 package.
+type RandomChaCha8 struct {
+	source *rand.ChaCha8
+	rand   *rand.Rand
+}
+
+// NewRandomChaCha8 returns RandomChaCha8 seeded with "seed" parameter. NewRandomChaCha8 can be
+// used as Random field of check.Config.
+func NewRandomChaCha8(seed int64) Random {
+	r := newRandomChaCha8()
+	r.Seed(seed)
+	return r
+}
+
+func newRandomChaCha8() *RandomChaCha8 {
+	source := rand.NewChaCha8([32]byte{})
+	return &RandomChaCha8{
+		source: source,
+		rand:   rand.New(source),
+	}
+}
+
+// Uint64 is implementation of Random.Uint64
+func (r *RandomChaCha8) Uint64(limit constraints.Uint64) uint64 {
+	return uint64N(r.rand, limit)
+}
+
+// Seed is implementation of Random.Seed. Seed is expanded to ChaCha8's 256 bit key
+// using SplitMix64.
+func (r *RandomChaCha8) Seed(seed int64) {
+	state := uint64(seed)
+	key := [32]byte{}
+	for index := 0; index < len(key); index += 8 {
+		binary.LittleEndian.PutUint64(key[index:], splitMix64(&state))
+	}
+	r.source.Seed(key)
+}
+
+// Split is implementation of Random.Split. Split Random's key is drawn from the
+// original and mixed by SplitMix64, which makes their streams independent.
+func (r *RandomChaCha8) Split() Random {
+	split := newRandomChaCha8()
+	key := [32]byte{}
+	for index := 0; index < len(key); index += 8 {
+		binary.LittleEndian.PutUint64(key[index:], mix64(r.rand.Uint64()))
+	}
+	split.source.Seed(key)
+	return split
+}
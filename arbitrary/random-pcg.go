@@ -0,0 +1,50 @@
+package arbitrary
+
+import (
+	"math/rand/v2"
+
+	"github.com/steffnova/go-check/constraints"
+)
+
+// RandomPCG is implementation of Random interface backed by [rand.PCG] source from
+// math/rand/v2 package.
+type RandomPCG struct {
+	source *rand.PCG
+	rand   *rand.Rand
+}
+
+// NewRandomPCG returns RandomPCG seeded with "seed" parameter. NewRandomPCG can be
+// used as Random field of check.Config.
+func NewRandomPCG(seed int64) Random {
+	r := newRandomPCG()
+	r.Seed(seed)
+	return r
+}
+
+func newRandomPCG() *RandomPCG {
+	source := rand.NewPCG(0, 0)
+	return &RandomPCG{
+		source: source,
+		rand:   rand.New(source),
+	}
+}
+
+// Uint64 is implementation of Random.Uint64
+func (r *RandomPCG) Uint64(limit constraints.Uint64) uint64 {
+	return uint64N(r.rand, limit)
+}
+
+// Seed is implementation of Random.Seed. Seed is expanded to PCG's 128 bit state
+// using SplitMix64.
+func (r *RandomPCG) Seed(seed int64) {
+	state := uint64(seed)
+	r.source.Seed(splitMix64(&state), splitMix64(&state))
+}
+
+// Split is implementation of Random.Split. Split Random is seeded with values drawn
+// from the original and mixed by SplitMix64, which makes their streams independent.
+func (r *RandomPCG) Split() Random {
+	split := newRandomPCG()
+	split.source.Seed(mix64(r.rand.Uint64()), mix64(r.rand.Uint64()))
+	return split
+}
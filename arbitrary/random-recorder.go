@@ -0,0 +1,53 @@
+package arbitrary
+
+import "github.com/steffnova/go-check/constraints"
+
+// Draw is a single call to Random.Uint64, recorded by [RandomRecorder].
+type Draw struct {
+	Limit constraints.Uint64 // Range passed to Random.Uint64
+	Value uint64             // Value returned by Random.Uint64
+}
+
+// RandomRecorder is a Random decorator that records every Uint64 call and it's result.
+// Randoms returned by Split are not recorded. Instead, they are seeded with a recorded
+// value, which keeps the record small while it stays replayable with [RandomReplay].
+type RandomRecorder struct {
+	random Random
+	draws  *[]Draw
+}
+
+// NewRandomRecorder returns RandomRecorder that records draws of "random" parameter.
+func NewRandomRecorder(random Random) RandomRecorder {
+	return RandomRecorder{
+		random: random,
+		draws:  &[]Draw{},
+	}
+}
+
+// Uint64 is implementation of Random.Uint64
+func (r RandomRecorder) Uint64(limit constraints.Uint64) uint64 {
+	value := r.random.Uint64(limit)
+	*r.draws = append(*r.draws, Draw{Limit: limit, Value: value})
+	return value
+}
+
+// Seed is implementation of Random.Seed. Seed is not recorded.
+func (r RandomRecorder) Seed(seed int64) {
+	r.random.Seed(seed)
+}
+
+// Split is implementation of Random.Split. Split Random is seeded with a value drawn from
+// it, which is recorded in place of it's draws. Draws of the recorded Random are the same
+// as if it wasn't recorded.
+func (r RandomRecorder) Split() Random {
+	split := r.random.Split()
+	seed := split.Uint64(constraints.Uint64Default())
+	*r.draws = append(*r.draws, Draw{Limit: constraints.Uint64Default(), Value: seed})
+	split.Seed(int64(seed))
+	return split
+}
+
+// Draws returns draws recorded so far.
+func (r RandomRecorder) Draws() []Draw {
+	return *r.draws
+}
@@ -0,0 +1,53 @@
+package arbitrary
+
+import "github.com/steffnova/go-check/constraints"
+
+// RandomReplay is implementation of Random interface that serves recorded values back,
+// in the order they were recorded (see [RandomRecorder]). Randoms returned by Split are
+// seeded with a replayed value, the same way [RandomRecorder] seeds them, and Seed has
+// no effect. Replay tolerates generators that changed since the values were recorded:
+// value outside of the requested range is clamped to it, and once recorded values are
+// exhausted lower limit of the range is returned.
+type RandomReplay struct {
+	values *[]uint64
+	random Random
+}
+
+// NewRandomReplay returns RandomReplay that replays "values" parameter. Randoms returned
+// by Split are split from "random" parameter, which must be of the same kind as the Random
+// whose draws were recorded.
+func NewRandomReplay(values []uint64, random Random) RandomReplay {
+	return RandomReplay{
+		values: &values,
+		random: random,
+	}
+}
+
+// Uint64 is implementation of Random.Uint64
+func (r RandomReplay) Uint64(limit constraints.Uint64) uint64 {
+	if len(*r.values) == 0 {
+		return limit.Min
+	}
+
+	value := (*r.values)[0]
+	*r.values = (*r.values)[1:]
+
+	switch {
+	case value < limit.Min:
+		return limit.Min
+	case value > limit.Max:
+		return limit.Max
+	default:
+		return value
+	}
+}
+
+// Seed is implementation of Random.Seed
+func (r RandomReplay) Seed(int64) {}
+
+// Split is implementation of Random.Split
+func (r RandomReplay) Split() Random {
+	split := r.random.Split()
+	split.Seed(int64(r.Uint64(constraints.Uint64Default())))
+	return split
+}
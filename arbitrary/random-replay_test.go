@@ -0,0 +1,75 @@
+package arbitrary
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestRandomReplay(t *testing.T) {
+	limits := []constraints.Uint64{
+		{Min: 0, Max: 10},
+		{Min: 100, Max: 1000},
+		constraints.Uint64Default(),
+	}
+
+	testCases := map[string]func(*testing.T){
+		"ReplaysRecordedDraws": func(t *testing.T) {
+			recorder := NewRandomRecorder(NewRandomNumber(0))
+			split := recorder.Split()
+
+			expected := []uint64{}
+			for _, limit := range limits {
+				expected = append(expected, recorder.Uint64(limit), split.Uint64(limit))
+			}
+
+			// Split is recorded as a single draw of it's seed, followed by draws of the recorder.
+			draws := recorder.Draws()
+			if len(draws) != len(limits)+1 {
+				t.Fatalf("Expected %d recorded draws. Got: %d", len(limits)+1, len(draws))
+			}
+			values := make([]uint64, len(draws))
+			for index, draw := range draws {
+				values[index] = draw.Value
+				if index > 0 && draw.Limit != limits[index-1] {
+					t.Fatalf("Expected draw limit: %v. Got: %v", limits[index-1], draw.Limit)
+				}
+			}
+
+			replay := NewRandomReplay(values, NewRandomNumber(1))
+			replaySplit := replay.Split()
+			replay.Seed(10)
+			replayed := []uint64{}
+			for _, limit := range limits {
+				replayed = append(replayed, replay.Uint64(limit), replaySplit.Uint64(limit))
+			}
+			if !reflect.DeepEqual(replayed, expected) {
+				t.Fatalf("Expected replayed values: %v. Got: %v", expected, replayed)
+			}
+		},
+		"RecordingKeepsDraws": func(t *testing.T) {
+			random, recorder := NewRandomNumber(0), NewRandomRecorder(NewRandomNumber(0))
+			for _, limit := range limits {
+				random.Split()
+				recorder.Split()
+				if expected, recorded := random.Uint64(limit), recorder.Uint64(limit); recorded != expected {
+					t.Fatalf("Expected recorded draw: %d. Got: %d", expected, recorded)
+				}
+			}
+		},
+		"ClampsValues": func(t *testing.T) {
+			replay := NewRandomReplay([]uint64{5, 50, 500}, NewRandomNumber(0))
+			limit := constraints.Uint64{Min: 10, Max: 100}
+
+			replayed := []uint64{replay.Uint64(limit), replay.Uint64(limit), replay.Uint64(limit), replay.Uint64(limit)}
+			if expected := []uint64{10, 50, 100, 10}; !reflect.DeepEqual(replayed, expected) {
+				t.Fatalf("Expected replayed values: %v. Got: %v", expected, replayed)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
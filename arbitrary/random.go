@@ -2,8 +2,9 @@ package arbitrary
 
 import (
 	"math"
-	"math/big"
+	"math/bits"
 	"math/rand"
+	randv2 "math/rand/v2"
 
 	"github.com/steffnova/go-check/constraints"
 )
@@ -21,28 +22,50 @@ type Random interface {
 	Seed(seed int64)
 }
 
-// RandomNumber is implementation of Random interface
+// RandomNumber is implementation of Random interface backed by math/rand package.
 type RandomNumber struct {
 	Rand *rand.Rand
 }
 
-// Uint64 is implementation of Random.Uint64
-func (r RandomNumber) Uint64(limit constraints.Uint64) uint64 {
-	max := big.NewInt(math.MaxInt64)
-	max = max.Mul(max, big.NewInt(int64(limit.Max/uint64(math.MaxInt64))))
-	max = max.Add(max, big.NewInt(int64(limit.Max%uint64(math.MaxInt64))))
+// NewRandomNumber returns RandomNumber seeded with "seed" parameter.
+func NewRandomNumber(seed int64) RandomNumber {
+	return RandomNumber{
+		Rand: rand.New(rand.NewSource(seed)),
+	}
+}
 
-	min := big.NewInt(math.MaxInt64)
-	min = min.Mul(min, big.NewInt(int64(limit.Min/uint64(math.MaxInt64))))
-	min = min.Add(min, big.NewInt(int64(limit.Min%uint64(math.MaxInt64))))
+// Uint64 is implementation of Random.Uint64. Values are sampled by rejection: random
+// bits are masked to the bit length of the range size until value falls within the
+// range. Sampling consumes the same random numbers as [big.Int.Rand], thus it generates
+// the same values for the same seed, without big.Int allocations.
+func (r RandomNumber) Uint64(limit constraints.Uint64) uint64 {
+	if limit.Min > limit.Max {
+		return limit.Min
+	}
 
-	diff := big.NewInt(0).Sub(max, min)
-	diff = diff.Add(diff, big.NewInt(1))
+	// Size of the range overflows to 0 when range covers all uint64 values, for which
+	// big.Int.Rand draws a two word value whose most significant word is masked to 1 bit.
+	size := limit.Max - limit.Min + 1
+	if size == 0 {
+		for {
+			n, msw := r.word(), r.word()
+			if msw&1 == 0 {
+				return n
+			}
+		}
+	}
 
-	n := diff.Rand(r.Rand, diff)
-	n = n.Add(diff, min)
+	mask := uint64(math.MaxUint64) >> (64 - bits.Len64(size))
+	for {
+		if n := r.word() & mask; n < size {
+			return limit.Min + n
+		}
+	}
+}
 
-	return n.Uint64()
+// word returns random 64 bit word, composed from two 32 bit random numbers.
+func (r RandomNumber) word() uint64 {
+	return uint64(r.Rand.Uint32()) | uint64(r.Rand.Uint32())<<32
 }
 
 // Seed is implementation of Random.Seed
@@ -57,3 +80,28 @@ func (r RandomNumber) Split() Random {
 		Rand: rand.New(rand.NewSource(newSeed)),
 	}
 }
+
+// uint64N returns uint64 in range [min, max] (inclusive) using math/rand/v2 generator.
+func uint64N(r *randv2.Rand, limit constraints.Uint64) uint64 {
+	switch size := limit.Max - limit.Min + 1; {
+	case limit.Min > limit.Max:
+		return limit.Min
+	case size == 0:
+		return r.Uint64()
+	default:
+		return limit.Min + r.Uint64N(size)
+	}
+}
+
+// splitMix64 advances SplitMix64 state and returns next value of it's sequence.
+func splitMix64(state *uint64) uint64 {
+	*state += 0x9e3779b97f4a7c15
+	return mix64(*state)
+}
+
+// mix64 is SplitMix64's finalizer, that mixes bits of the value.
+func mix64(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
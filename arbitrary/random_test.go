@@ -0,0 +1,147 @@
+package arbitrary
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/constraints"
+)
+
+// bigUint64 generates random uint64 in range using big.Int.Rand.
+func bigUint64(r *rand.Rand, limit constraints.Uint64) uint64 {
+	min := new(big.Int).SetUint64(limit.Min)
+	size := new(big.Int).Sub(new(big.Int).SetUint64(limit.Max), min)
+	size = size.Add(size, big.NewInt(1))
+	return new(big.Int).Add(new(big.Int).Rand(r, size), min).Uint64()
+}
+
+func TestRandomNumberUint64(t *testing.T) {
+	limits := []constraints.Uint64{
+		{Min: 0, Max: 0},
+		{Min: 10, Max: 10},
+		{Min: 0, Max: 1},
+		{Min: 0, Max: 100},
+		{Min: 100, Max: 1000},
+		{Min: 0, Max: math.MaxUint32},
+		{Min: 1 << 63, Max: math.MaxUint64},
+		{Min: 0, Max: math.MaxInt64},
+		{Min: 1, Max: math.MaxUint64},
+		{Min: 0, Max: math.MaxUint64},
+	}
+
+	for _, limit := range limits {
+		r := RandomNumber{Rand: rand.New(rand.NewSource(0))}
+		expected := rand.New(rand.NewSource(0))
+
+		for i := 0; i < 1000; i++ {
+			n, m := r.Uint64(limit), bigUint64(expected, limit)
+			if n != m {
+				t.Fatalf("Limit %v: expected value %d to match big.Int.Rand value %d", limit, n, m)
+			}
+			if n < limit.Min || n > limit.Max {
+				t.Fatalf("Value %d is out of range %v", n, limit)
+			}
+		}
+	}
+}
+
+func TestRandomBackends(t *testing.T) {
+	backends := map[string]func(seed int64) Random{
+		"RandomNumber": func(seed int64) Random { return NewRandomNumber(seed) },
+		"PCG":          func(seed int64) Random { return NewRandomPCG(seed) },
+		"ChaCha8":      func(seed int64) Random { return NewRandomChaCha8(seed) },
+	}
+
+	limits := []constraints.Uint64{
+		{Min: 5, Max: 5},
+		{Min: 0, Max: 100},
+		{Min: 1 << 63, Max: math.MaxUint64},
+		{Min: 0, Max: math.MaxUint64},
+	}
+
+	draw := func(r Random, limit constraints.Uint64, n int) []uint64 {
+		values := make([]uint64, n)
+		for index := range values {
+			values[index] = r.Uint64(limit)
+		}
+		return values
+	}
+
+	for name, backend := range backends {
+		t.Run(name, func(t *testing.T) {
+			for _, limit := range limits {
+				for _, n := range draw(backend(0), limit, 1000) {
+					if n < limit.Min || n > limit.Max {
+						t.Fatalf("Value %d is out of range %v", n, limit)
+					}
+				}
+			}
+
+			limit := constraints.Uint64Default()
+			if !reflect.DeepEqual(draw(backend(1), limit, 10), draw(backend(1), limit, 10)) {
+				t.Fatalf("Expected the same values for the same seed")
+			}
+			if reflect.DeepEqual(draw(backend(1), limit, 10), draw(backend(2), limit, 10)) {
+				t.Fatalf("Expected different values for different seeds")
+			}
+
+			r := backend(1)
+			r.Uint64(limit)
+			r.Seed(1)
+			if !reflect.DeepEqual(draw(r, limit, 10), draw(backend(1), limit, 10)) {
+				t.Fatalf("Expected Seed to reset the random")
+			}
+
+			parent, expected := backend(1), backend(1)
+			split := parent.Split()
+			expected.Split()
+			split.Seed(100)
+			draw(split, limit, 10)
+			if !reflect.DeepEqual(draw(parent, limit, 10), draw(expected, limit, 10)) {
+				t.Fatalf("Expected split random not to affect the original")
+			}
+			if reflect.DeepEqual(draw(parent.Split(), limit, 10), draw(parent.Split(), limit, 10)) {
+				t.Fatalf("Expected split randoms to generate different values")
+			}
+		})
+	}
+}
+
+func BenchmarkRandomNumberUint64(b *testing.B) {
+	limit := constraints.Uint64{Min: 0, Max: 1000}
+
+	b.Run("RandomNumber", func(b *testing.B) {
+		r := RandomNumber{Rand: rand.New(rand.NewSource(0))}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			r.Uint64(limit)
+		}
+	})
+
+	b.Run("PCG", func(b *testing.B) {
+		r := NewRandomPCG(0)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			r.Uint64(limit)
+		}
+	})
+
+	b.Run("ChaCha8", func(b *testing.B) {
+		r := NewRandomChaCha8(0)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			r.Uint64(limit)
+		}
+	})
+
+	b.Run("BigInt", func(b *testing.B) {
+		r := rand.New(rand.NewSource(0))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bigUint64(r, limit)
+		}
+	})
+}
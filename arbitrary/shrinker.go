@@ -14,22 +14,17 @@ func (shrinker Shrinker) Fail(err error) Shrinker {
 }
 
 func (shrinker Shrinker) Map(mapper interface{}) Shrinker {
-	mapperVal := reflect.ValueOf(mapper)
-
+	in, _, mapFn, err := mapperOf(mapper)
 	switch {
-	case mapperVal.Kind() != reflect.Func:
-		return shrinker.Fail(fmt.Errorf("mapper must be a function"))
-	case mapperVal.Type().NumIn() != 1:
-		return shrinker.Fail(fmt.Errorf("mapper must have 1 input value"))
-	case mapperVal.Type().NumOut() != 1:
-		return shrinker.Fail(fmt.Errorf("mapper must have 1 output value"))
+	case err != nil:
+		return shrinker.Fail(err)
 	case shrinker == nil:
 		return nil
 	}
 
 	return func(arb Arbitrary, propertyFailed bool) (Arbitrary, error) {
 
-		if mapperVal.Type().In(0) != arb.Precursors[0].Value.Type() {
+		if in != arb.Precursors[0].Value.Type() {
 			return Arbitrary{}, fmt.Errorf("mapper input type must match shrink type")
 		}
 
@@ -39,7 +34,7 @@ func (shrinker Shrinker) Map(mapper interface{}) Shrinker {
 		}
 
 		return Arbitrary{
-			Value:      mapperVal.Call([]reflect.Value{shrink.Value})[0],
+			Value:      mapFn(shrink.Value),
 			Precursors: []Arbitrary{shrink},
 			Shrinker:   shrink.Shrinker.Map(mapper),
 		}, nil
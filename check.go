@@ -4,7 +4,6 @@ import (
 	"flag"
 	"fmt"
 	"hash/maphash"
-	"math/rand"
 	"testing"
 
 	"github.com/steffnova/go-check/arbitrary"
@@ -15,12 +14,23 @@ import (
 var (
 	seedFlag       = flag.CommandLine.Int64("seed", int64(new(maphash.Hash).Sum64()), "seed value used for generating property inputs")
 	iterationsFlag = flag.CommandLine.Int64("iterations", 100, "number of iterations run for the property")
+	replayFlag     = flag.CommandLine.String("replay", "", "replay token of failed property run, printed by failed check")
 )
 
 // Config is configuration used by Check.
 type Config struct {
 	Seed       int64 // Seed used by random number generator
 	Iterations int64 // Number of times property will be checked
+
+	// Random creates random number generator seeded with Seed. If Random is nil,
+	// [arbitrary.NewRandomNumber] is used. Other backends are created with
+	// [arbitrary.NewRandomPCG] and [arbitrary.NewRandomChaCha8].
+	Random func(seed int64) arbitrary.Random
+
+	// Replay is a token of failed property run, printed by Check. If Replay is set,
+	// property is run only once, using the values drawn by the failed run instead of
+	// values drawn from random number generator seeded with Seed.
+	Replay string
 }
 
 // Check checks if property holds. First parameter is *testing.T that will report
@@ -72,7 +82,13 @@ type Config struct {
 //	    Failure reason: commutativity does not hold for subtraction.
 //
 //	    Re-run:
-//	    go test -run=TestSubtractionCommutativity -seed=1646421732271105000 -iterations=100
+//	    go test -run=TestSubtractionCommutativity -replay=A8gByAEEltjFm7HRss7NAdO7t5qGy5P1CuiD5Iapv4jTjQHbgaWmr4bWtGOFAf___________________-8e
+//
+// Re-run command replays values drawn from random number generator by the failed run, which
+// reproduces it without running the iterations that preceded it. Unlike the seed, replay isn't
+// affected by code changes that alter values drawn by those iterations, and tolerates changes to
+// generators as long as they draw values the same way. Replay reports if shrinking didn't follow
+// the same predicate's outcomes as the failed run, which happens when predicate is not deterministic.
 func Check(t *testing.T, property property.Property, config ...Config) {
 	t.Helper()
 	if property == nil {
@@ -81,15 +97,48 @@ func Check(t *testing.T, property property.Property, config ...Config) {
 	configuration := Config{
 		Seed:       *seedFlag,
 		Iterations: *iterationsFlag,
+		Replay:     *replayFlag,
 	}
 
 	if len(config) > 0 {
 		configuration = config[0]
 	}
 
-	random := arbitrary.RandomNumber{
-		Rand: rand.New(rand.NewSource(configuration.Seed)),
+	newRandom := func(seed int64) arbitrary.Random {
+		return arbitrary.NewRandomNumber(seed)
 	}
+	if configuration.Random != nil {
+		newRandom = configuration.Random
+	}
+
+	if configuration.Replay != "" {
+		replay, err := parseReplay(configuration.Replay)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		details, err := property(arbitrary.NewRandomReplay(replay.values, newRandom(0)), replay.bias)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if details.FailureReason != nil {
+			diverged := ""
+			if replay.diverged(details.ShrinkPath) {
+				diverged = "\nShrinking diverged from the failed run, predicate might not be deterministic."
+			}
+			t.Fatal(
+				"\nCheck failed when replaying failed run.",
+				fmt.Sprintf("\n%s", (propertyFailed(details.FailureInput))),
+				fmt.Sprintf("\nShrunk %d time(s)", details.NumberOfShrinks),
+				fmt.Sprintf("\nFailure reason: %s", details.FailureReason),
+				diverged,
+			)
+		}
+		return
+	}
+
+	random := newRandom(configuration.Seed)
 
 	for i := int64(0); i < configuration.Iterations; i++ {
 		bias := constraints.Bias{
@@ -97,18 +146,20 @@ func Check(t *testing.T, property property.Property, config ...Config) {
 			Scaling: int(configuration.Iterations) - int(i),
 		}
 
-		details, err := property(random, bias)
+		recorder := arbitrary.NewRandomRecorder(random)
+		details, err := property(recorder, bias)
 		if err != nil {
 			t.Fatal(err)
 		}
 
 		if details.FailureReason != nil {
+			replay := newReplay(bias, recorder.Draws(), details.ShrinkPath)
 			t.Fatal(
 				fmt.Sprintf("\nCheck failed after %d test(s) with seed: %d.", i, configuration.Seed),
-				fmt.Sprintf("\n%s", (propertyFailed(details.FailureInput.Values()))),
+				fmt.Sprintf("\n%s", (propertyFailed(details.FailureInput))),
 				fmt.Sprintf("\nShrunk %d time(s)", details.NumberOfShrinks),
 				fmt.Sprintf("\nFailure reason: %s", details.FailureReason),
-				fmt.Sprintf("\n\nRe-run:\ngo test -run=%s -seed=%d -iterations=%d", t.Name(), configuration.Seed, configuration.Iterations),
+				fmt.Sprintf("\n\nRe-run:\ngo test -run=%s -replay=%s", t.Name(), replay),
 			)
 		}
 	}
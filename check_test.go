@@ -0,0 +1,116 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+	"github.com/steffnova/go-check/property"
+)
+
+func TestCheck(t *testing.T) {
+	backends := map[string]func(seed int64) arbitrary.Random{
+		"Default": nil,
+		"PCG":     arbitrary.NewRandomPCG,
+		"ChaCha8": arbitrary.NewRandomChaCha8,
+	}
+
+	for name, backend := range backends {
+		t.Run(name, func(t *testing.T) {
+			Check(t, property.Define(
+				property.Inputs(generator.Slice(generator.Int())),
+				property.Predicate(func(numbers []int) error {
+					sum, reversed := 0, 0
+					for index := range numbers {
+						sum += numbers[index]
+						reversed += numbers[len(numbers)-1-index]
+					}
+					if sum != reversed {
+						return fmt.Errorf("sum %d doesn't match sum in reverse order %d", sum, reversed)
+					}
+					return nil
+				}),
+			), Config{
+				Seed:       0,
+				Iterations: 100,
+				Random:     backend,
+			})
+		})
+	}
+}
+
+func TestPropertyFailed(t *testing.T) {
+	p := property.Define(
+		property.Inputs(generator.Func(generator.Int())),
+		property.Predicate(func(f func(int) int) error {
+			if f(1) != f(2) {
+				return fmt.Errorf("function is not constant")
+			}
+			return nil
+		}),
+	)
+
+	for i := int64(0); i < 100; i++ {
+		details, err := p(arbitrary.NewRandomNumber(i), constraints.Bias{Size: 100, Scaling: 100})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if details.FailureReason == nil {
+			continue
+		}
+
+		expected := "Property failed for inputs: [\n\t<func(int) int> func{1 -> 0, _ -> -1}\n]"
+		if report := propertyFailed(details.FailureInput).Error(); report != expected {
+			t.Fatalf("Expected report: %s. Got: %s", expected, report)
+		}
+		return
+	}
+	t.Fatalf("Expected property to fail")
+}
+
+func TestPropertyFailedNestedFunc(t *testing.T) {
+	p := property.Define(
+		property.Inputs(generator.Slice(generator.OneFrom(generator.Func(generator.Int())), constraints.Length{Min: 1, Max: 1})),
+		property.Predicate(func(fs []func(int) int) error {
+			if fs[0](1) != fs[0](2) {
+				return fmt.Errorf("function is not constant")
+			}
+			return nil
+		}),
+	)
+
+	for i := int64(0); i < 100; i++ {
+		details, err := p(arbitrary.NewRandomNumber(i), constraints.Bias{Size: 100, Scaling: 100})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if details.FailureReason == nil {
+			continue
+		}
+
+		expected := "Property failed for inputs: [\n\t<[]func(int) int> [func{1 -> 0, _ -> 1}]\n]"
+		if report := propertyFailed(details.FailureInput).Error(); report != expected {
+			t.Fatalf("Expected report: %s. Got: %s", expected, report)
+		}
+		return
+	}
+	t.Fatalf("Expected property to fail")
+}
+
+func TestPropertyFailedCycle(t *testing.T) {
+	type node struct {
+		Value int
+		Next  *node
+	}
+
+	cycle := &node{Value: 1}
+	cycle.Next = cycle
+
+	expected := "Property failed for inputs: [\n\t<*check.node> &1 <check.node> {\"Value\": <int> 1, \"Next\": <*check.node> (&1)}\n]"
+	if report := propertyFailed(arbitrary.Arbitraries{{Value: reflect.ValueOf(cycle)}}).Error(); report != expected {
+		t.Fatalf("Expected report: %s. Got: %s", expected, report)
+	}
+}
@@ -0,0 +1,14 @@
+package constraints
+
+// Alias constraints
+type Alias struct {
+	Frequency uint64 // Frequency of pointers that alias one of the previously generated pointers
+}
+
+// AliasDefault returns default alias constraints. One in 4 pointers aliases one of the
+// previously generated pointers.
+func AliasDefault() Alias {
+	return Alias{
+		Frequency: 4,
+	}
+}
@@ -0,0 +1,39 @@
+package constraints
+
+import "time"
+
+// ChanFeed constraints
+type ChanFeed struct {
+	Values    Length        // Number of values sent to the channel
+	Buffer    Length        // Channel's buffer size
+	MaxDelay  time.Duration // Max delay before each send and before closing the channel
+	LeaveOpen bool          // Allows channel to be left open after all values are sent
+}
+
+// ChanFeedDefault returns default chan feed constraints. Between 0 and 10 values are sent
+// to unbuffered or buffered channel (up to 10 values), with delays of up to 1ms, and channel
+// is always closed after the last value is sent.
+func ChanFeedDefault() ChanFeed {
+	return ChanFeed{
+		Values:   Length{Min: 0, Max: 10},
+		Buffer:   Length{Min: 0, Max: 10},
+		MaxDelay: time.Millisecond,
+	}
+}
+
+// ChanSent constraints
+type ChanSent struct {
+	Buffer   Length        // Channel's buffer size
+	Delays   Length        // Number of delayed receives
+	MaxDelay time.Duration // Max delay before each delayed receive
+}
+
+// ChanSentDefault returns default chan sent constraints. Channel is unbuffered or buffered
+// (up to 10 values), and up to 10 receives are delayed for up to 1ms.
+func ChanSentDefault() ChanSent {
+	return ChanSent{
+		Buffer:   Length{Min: 0, Max: 10},
+		Delays:   Length{Min: 0, Max: 10},
+		MaxDelay: time.Millisecond,
+	}
+}
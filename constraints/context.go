@@ -0,0 +1,23 @@
+package constraints
+
+import "time"
+
+// Context constraints
+type Context struct {
+	Deadline Duration // Range of durations from context's creation until its deadline
+	Cancel   Duration // Range of delays from context's creation until it's cancelled
+	Values   Length   // Number of values stored in the context
+	Optional Ptr      // Frequency of contexts without deadline, and without cancellation
+}
+
+// ContextDefault returns default context constraints. Half of the contexts have a deadline
+// within 10ms, and half of them are cancelled within 10ms. Up to 5 values are stored in
+// the context.
+func ContextDefault() Context {
+	return Context{
+		Deadline: Duration{Min: 0, Max: 10 * time.Millisecond},
+		Cancel:   Duration{Min: 0, Max: 10 * time.Millisecond},
+		Values:   Length{Min: 0, Max: 5},
+		Optional: Ptr{NilFrequency: 2},
+	}
+}
@@ -0,0 +1,18 @@
+package constraints
+
+import "testing/iotest"
+
+// Failure constraints
+type Failure struct {
+	Rate   uint64  // Max percentage of calls [0, 100] that fail with an injected error
+	Errors []error // Errors that are injected
+}
+
+// FailureDefault returns default failure constraints. Up to 50% of calls fail with
+// [iotest.ErrTimeout].
+func FailureDefault() Failure {
+	return Failure{
+		Rate:   50,
+		Errors: []error{iotest.ErrTimeout},
+	}
+}
@@ -0,0 +1,18 @@
+package constraints
+
+// Graph constraints
+type Graph struct {
+	Nodes     Length // Number of graph's nodes
+	Density   uint64 // Percentage of possible edges graph has, besides the ones that keep it connected
+	Acyclic   bool   // Graph has no cycles, as edges go from nodes with lower to nodes with higher index
+	Connected bool   // Graph is (weakly) connected
+}
+
+// GraphDefault returns default graph constraints. Graphs have between 0 and 10 nodes,
+// and 20% of possible edges. Graphs can have cycles and don't have to be connected.
+func GraphDefault() Graph {
+	return Graph{
+		Nodes:   Length{Min: 0, Max: 10},
+		Density: 20,
+	}
+}
@@ -0,0 +1,22 @@
+package constraints
+
+import "testing/iotest"
+
+// Reader constraints
+type Reader struct {
+	Data     Length // Range of the number of bytes reader returns
+	Chunks   Length // Range of the number of reads whose size is limited
+	MaxChunk uint64 // Max number of bytes returned by read whose size is limited
+	Err      error  // Error returned by failing reader. Reader never fails if nil
+}
+
+// ReaderDefault returns default reader constraints. Reader returns [0, 100] bytes, up to
+// 10 reads return at most 16 bytes and failing reader returns [iotest.ErrTimeout].
+func ReaderDefault() Reader {
+	return Reader{
+		Data:     LengthDefault(),
+		Chunks:   Length{Min: 0, Max: 10},
+		MaxChunk: 16,
+		Err:      iotest.ErrTimeout,
+	}
+}
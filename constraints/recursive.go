@@ -0,0 +1,26 @@
+package constraints
+
+// Recursive constraints
+type Recursive struct {
+	Depth     uint   // Max number of recursive cases on any path from the root to a base case
+	Frequency uint64 // Frequency of choosing recursive case over base case at the root
+}
+
+// RecursiveDefault returns default recursive constraints. Recursion is at most 10
+// levels deep, and recursive case is chosen 3 times more often than base case at the root.
+func RecursiveDefault() Recursive {
+	return Recursive{
+		Depth:     10,
+		Frequency: 3,
+	}
+}
+
+// Biased returns recursive constraints whose frequency is scaled by bias, so that
+// recursive case is chosen less often for smaller sizes. Non-zero frequency is never
+// scaled below 1.
+func (r Recursive) Biased(bias Bias) Recursive {
+	if bias.Size > 0 && bias.Scaling > 0 && bias.Scaling <= bias.Size && r.Frequency > 0 {
+		r.Frequency = max(1, r.Frequency*uint64(bias.Size-bias.Scaling+1)/uint64(bias.Size))
+	}
+	return r
+}
@@ -1,10 +1,18 @@
 package constraints
 
+import "unicode"
+
+// Rune constraints
 type Rune struct {
-	MinCodePoint int32
-	MaxCodePoint int32
+	MinCodePoint int32                 // Min code point value
+	MaxCodePoint int32                 // Max code point value
+	Tables       []*unicode.RangeTable // Categories or scripts (e.g. unicode.L, unicode.Han) code points must be in. All code points are allowed if empty
+	Exclude      []*unicode.RangeTable // Categories or scripts code points must not be in (e.g. unicode.Cs for surrogates)
+	Assigned     bool                  // Allows only code points assigned to a character, excluding unassigned code points and surrogates
 }
 
+// RuneDefault returns default rune constraints that include all code points
+// in [0, 0x10ffff] range.
 func RuneDefault() Rune {
 	return Rune{
 		MinCodePoint: 0,
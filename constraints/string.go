@@ -1,10 +1,16 @@
 package constraints
 
+// String constraints. Forms are applied after the runes are generated within Length, thus
+// number of runes in resulting string can be out of Length range (e.g. NFD decomposes a
+// single rune into several, while NFC composes several runes into one).
 type String struct {
-	Rune   Rune
-	Length Length
+	Rune   Rune                  // Constraints of string's runes
+	Length Length                // Number of string's runes
+	Forms  []func(string) string // Normalization forms (e.g. norm.NFC.String and norm.NFD.String from golang.org/x/text/unicode/norm), one of which is applied to each string
 }
 
+// StringDefault returns default string constraints. Strings have between 0 and 100
+// runes, with code points in [0, 0x10ffff] range.
 func StringDefault() String {
 	return String{
 		Rune:   RuneDefault(),
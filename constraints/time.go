@@ -0,0 +1,41 @@
+package constraints
+
+import (
+	"math"
+	"time"
+)
+
+// Time constraints
+type Time struct {
+	Min       time.Time        // Min time value
+	Max       time.Time        // Max time value
+	Anchor    time.Time        // Time value towards which time values are shrunk (Unix epoch if zero)
+	Locations []*time.Location // Locations generated time values can be in
+}
+
+// TimeDefault returns default time constraints. Time values are generated in
+// [0001-01-01 00:00:00, 9999-12-31 23:59:59.999999999] range in UTC location
+// and are shrunk towards Unix epoch.
+func TimeDefault() Time {
+	return Time{
+		Min:       time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Max:       time.Date(9999, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+		Anchor:    time.Unix(0, 0).UTC(),
+		Locations: []*time.Location{time.UTC},
+	}
+}
+
+// Duration constraints
+type Duration struct {
+	Min time.Duration // Min duration value
+	Max time.Duration // Max duration value
+}
+
+// DurationDefault returns default duration constraints that include all
+// time.Duration values [math.MinInt64, math.MaxInt64].
+func DurationDefault() Duration {
+	return Duration{
+		Min: math.MinInt64,
+		Max: math.MaxInt64,
+	}
+}
@@ -2,8 +2,9 @@ package check
 
 import (
 	"fmt"
-	"reflect"
 	"strings"
+
+	"github.com/steffnova/go-check/arbitrary"
 )
 
 type propertyError func() string
@@ -12,11 +13,13 @@ func (pe propertyError) Error() string {
 	return pe()
 }
 
-func propertyFailed(inputs []reflect.Value) propertyError {
+func propertyFailed(inputs arbitrary.Arbitraries) propertyError {
 	return func() string {
 		inputData := make([]string, len(inputs))
 		for index, input := range inputs {
-			inputData[index] = fmt.Sprintf("<%s> %#v", input.Type().String(), input.Interface())
+			// Generated functions are encoded as tables of their inputs and outputs, while
+			// pointers that alias each other or form cycles are encoded with back-references
+			inputData[index] = arbitrary.EncodeArbitraryToString(input)
 		}
 
 		return fmt.Sprintf("Property failed for inputs: [\n\t%s\n]", strings.Join(inputData, ",\n\t"))
@@ -0,0 +1,13 @@
+/*
+Package gen provides type safe wrappers around generators defined in generator package.
+[Gen] is a generator of values of type T, and combinators that operate on it ([Transform],
+[Bind] and [Gen.Filter]) accept typed functions instead of interface{} values that are
+validated at runtime. Mapper, binder or predicate whose signature doesn't match generated
+values is reported by the compiler, instead of failing with an error during test run.
+
+Generators created by this package can be used anywhere [arbitrary.Generator] is expected
+by calling [Gen.Generator], and existing generators can be wrapped with [From]. Because Go
+methods can't declare type parameters, typed mapping is provided by [Transform] function,
+while [Map] generates map values.
+*/
+package gen
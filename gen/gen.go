@@ -0,0 +1,118 @@
+package gen
+
+import (
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// Gen is a generator of values of type T.
+type Gen[T any] struct {
+	generator arbitrary.Generator
+}
+
+// Pair is a tuple of two values, generated by [Zip] generator.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is a tuple of three values, generated by [Zip3] generator.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// From returns generator of values of type T that uses "generator" parameter to generate
+// values. Generator must be able to generate values of type T, otherwise an error is
+// returned when values are generated.
+func From[T any](generator arbitrary.Generator) Gen[T] {
+	return Gen[T]{generator: generator}
+}
+
+// Of returns generator of values of type T, that uses [generator.Any] to generate values.
+func Of[T any]() Gen[T] {
+	return From[T](generator.Any())
+}
+
+// Constant returns generator that always generates the value specified by "constant"
+// parameter.
+func Constant[T any](constant T) Gen[T] {
+	return From[T](func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		return arbitrary.Arbitrary{Value: reflect.ValueOf(&constant).Elem()}, nil
+	})
+}
+
+// OneOf returns generator that generates values using one of the generators. Generated
+// values are shrunk the same way as values generated by [generator.OneFrom].
+func OneOf[T any](first Gen[T], rest ...Gen[T]) Gen[T] {
+	generators := make([]arbitrary.Generator, len(rest))
+	for index, g := range rest {
+		generators[index] = g.generator
+	}
+	return From[T](generator.OneFrom(first.generator, generators...))
+}
+
+// Slice returns generator of slices whose elements are generated by "element" parameter.
+// Limits are the same as for [generator.Slice].
+func Slice[T any](element Gen[T], limits ...constraints.Length) Gen[[]T] {
+	return From[[]T](generator.Slice(element.generator, limits...))
+}
+
+// Map returns generator of maps whose keys and values are generated by "key" and "value"
+// parameters. Limits are the same as for [generator.Map].
+func Map[K comparable, V any](key Gen[K], value Gen[V], limits ...constraints.Length) Gen[map[K]V] {
+	return From[map[K]V](generator.Map(key.generator, value.generator, limits...))
+}
+
+// Ptr returns generator of pointers to values generated by "element" parameter. Limits are
+// the same as for [generator.Ptr].
+func Ptr[T any](element Gen[T], limits ...constraints.Ptr) Gen[*T] {
+	return From[*T](generator.Ptr(element.generator, limits...))
+}
+
+// Zip returns generator of pairs whose values are generated by "first" and "second"
+// parameters.
+func Zip[A, B any](first Gen[A], second Gen[B]) Gen[Pair[A, B]] {
+	return From[Pair[A, B]](generator.Tuple(first.generator, second.generator))
+}
+
+// Zip3 returns generator of triples whose values are generated by "first", "second" and
+// "third" parameters.
+func Zip3[A, B, C any](first Gen[A], second Gen[B], third Gen[C]) Gen[Triple[A, B, C]] {
+	return From[Triple[A, B, C]](generator.Tuple(first.generator, second.generator, third.generator))
+}
+
+// Transform (combinator) returns generator that maps values generated by "g" parameter using
+// "mapper" parameter. It is a typed counterpart of [arbitrary.Generator.Map].
+func Transform[T, U any](g Gen[T], mapper func(T) U) Gen[U] {
+	return From[U](g.generator.Map(mapper))
+}
+
+// Bind (combinator) returns generator that uses values generated by "g" parameter to create
+// the generator of resulting values. It is a typed counterpart of [arbitrary.Generator.Bind].
+func Bind[T, U any](g Gen[T], binder func(T) Gen[U]) Gen[U] {
+	return From[U](g.generator.Bind(func(value T) arbitrary.Generator {
+		return binder(value).generator
+	}))
+}
+
+// Filter (combinator) returns generator that generates only values that satisfy "predicate"
+// parameter. It is a typed counterpart of [arbitrary.Generator.Filter].
+func (g Gen[T]) Filter(predicate func(T) bool) Gen[T] {
+	return From[T](g.generator.Filter(predicate))
+}
+
+// Generator returns [arbitrary.Generator] of values of type T, that can be used with
+// generators and properties that operate on [arbitrary.Generator].
+func (g Gen[T]) Generator() arbitrary.Generator {
+	return g.generator
+}
+
+// Target returns the type of values generated by generator.
+func (g Gen[T]) Target() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
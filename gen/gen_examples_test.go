@@ -0,0 +1,38 @@
+package gen_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/gen"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to compose typed generators. Mapper passed to Transform and
+// predicate passed to Filter are checked by the compiler.
+func ExampleTransform() {
+	words := gen.From[string](generator.StringMatching("[a-z]{2,5}"))
+	sentences := gen.Transform(gen.Slice(words, constraints.Length{Min: 1, Max: 4}), func(words []string) string {
+		return strings.Join(words, " ")
+	}).Filter(func(sentence string) bool {
+		return len(sentence) > 5
+	})
+
+	streamer := generator.Streamer(
+		func(sentence string) {
+			fmt.Println(sentence)
+		},
+		sentences.Generator(),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// qw fwu adnri
+	// yoa mxp cbnmw ny
+	// znt la inz ac
+	// ek ee woohr arjor
+	// ydy krmcw
+}
@@ -0,0 +1,160 @@
+package gen
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+func TestGen(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"Of": func(t *testing.T) {
+			err := generator.Stream(0, 100, generator.Streamer(
+				func(map[int8][]bool) {},
+				Of[map[int8][]bool]().Generator(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"FromInvalidTarget": func(t *testing.T) {
+			err := generator.Stream(0, 10, generator.Streamer(
+				func(string) {},
+				From[string](generator.Int()).Generator(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"Constant": func(t *testing.T) {
+			var expected error = errors.New("constant")
+			err := generator.Stream(0, 10, generator.Streamer(
+				func(err error) {
+					if err != expected {
+						t.Fatalf("Expected constant error. Got: %v", err)
+					}
+				},
+				Constant(expected).Generator(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Slice": func(t *testing.T) {
+			g := Slice(From[int](generator.Int(constraints.Int{Min: 0, Max: 9})), constraints.Length{Min: 2, Max: 5})
+			err := generator.Stream(0, 100, generator.Streamer(
+				func(s []int) {
+					if len(s) < 2 || len(s) > 5 {
+						t.Fatalf("Invalid slice length: %d", len(s))
+					}
+					for _, n := range s {
+						if n < 0 || n > 9 {
+							t.Fatalf("Invalid element: %d", n)
+						}
+					}
+				},
+				g.Generator(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Map": func(t *testing.T) {
+			g := Map(Of[string](), Ptr(Of[bool]()), constraints.Length{Min: 1, Max: 5})
+			err := generator.Stream(0, 100, generator.Streamer(
+				func(m map[string]*bool) {
+					if len(m) < 1 || len(m) > 5 {
+						t.Fatalf("Invalid map length: %d", len(m))
+					}
+				},
+				g.Generator(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Zip": func(t *testing.T) {
+			g := Zip3(Constant("key"), From[uint](generator.Uint(constraints.Uint{Min: 1, Max: 3})), OneOf(Constant(true), Constant(false)))
+			err := generator.Stream(0, 100, generator.Streamer(
+				func(triple Triple[string, uint, bool]) {
+					if triple.First != "key" || triple.Second < 1 || triple.Second > 3 {
+						t.Fatalf("Invalid triple: %+v", triple)
+					}
+				},
+				g.Generator(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Transform": func(t *testing.T) {
+			g := Transform(Of[uint8](), func(n uint8) int {
+				return int(n) * 2
+			})
+			err := generator.Stream(0, 100, generator.Streamer(
+				func(n int) {
+					if n%2 != 0 || n > 510 {
+						t.Fatalf("Invalid value: %d", n)
+					}
+				},
+				g.Generator(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Filter": func(t *testing.T) {
+			g := Of[int]().Filter(func(n int) bool {
+				return n%3 == 0
+			})
+			err := generator.Stream(0, 100, generator.Streamer(
+				func(n int) {
+					if n%3 != 0 {
+						t.Fatalf("Value %d doesn't satisfy predicate", n)
+					}
+				},
+				g.Generator(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Bind": func(t *testing.T) {
+			g := Bind(From[int](generator.Int(constraints.Int{Min: 0, Max: 10})), func(n int) Gen[[]bool] {
+				return Slice(Of[bool](), constraints.Length{Min: uint64(n), Max: uint64(n)})
+			})
+			err := generator.Stream(0, 100, generator.Streamer(
+				func(s []bool) {
+					if len(s) > 10 {
+						t.Fatalf("Invalid slice length: %d", len(s))
+					}
+				},
+				g.Generator(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Target": func(t *testing.T) {
+			if target := Of[[]error]().Target().String(); target != "[]error" {
+				t.Fatalf("Invalid target: %s", target)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
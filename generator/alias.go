@@ -0,0 +1,152 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// Alias is a type that is provided by the [Aliasing] generator when defining a generator
+// with pointers that can alias each other. It returns generator of pointers to values
+// generated by "element" generator. The "limits" parameter, even though it is variadic,
+// evaluates only the first instance of [constraints.Alias]. If limits are omitted, limits
+// passed to Aliasing generator are used instead.
+type Alias func(element arbitrary.Generator, limits ...constraints.Alias) arbitrary.Generator
+
+// aliasKey identifies a pointer generated by [Alias] generator.
+type aliasKey struct {
+	Type    reflect.Type
+	Address uintptr
+}
+
+// aliasKeyOf returns the key of a pointer.
+func aliasKeyOf(pointer reflect.Value) aliasKey {
+	return aliasKey{Type: pointer.Type(), Address: pointer.Pointer()}
+}
+
+// Aliasing can be used to define generators of values whose pointers alias each other or form
+// cycles (e.g. graphs or doubly linked lists). The "aliased" parameter is a function that provides
+// an [Alias] function, which can be used to specify pointer generators. Pointer generated by Alias
+// generator either aliases one of the pointers of the same type, previously generated within the
+// same value (including pointers whose values are still being generated, which forms cycles), or
+// it's a new pointer to a value generated by element generator. Pointer is an alias with 1/Frequency
+// chance, if there are previously generated pointers of the same type. Frequency of 0 ensures no
+// aliases will be generated, while Frequency of 1 ensures new pointer is generated only if there are
+// no previously generated pointers. The "limits" parameter, even though it is variadic, evaluates
+// only the first instance of [constraints.Alias]. If limits are omitted, [constraints.AliasDefault]
+// is used instead. Generated values are shrunk by shrinking the values new pointers point to, while
+// aliases keep pointing to them. As pointers are shared, values they point to are updated in place
+// during shrinking, thus Aliasing generator should be used for the whole value passed to the property.
+// For the same reason, property must not retain the pointers, or the values they point to, between
+// it's runs, as those values change when the next shrink candidate is created.
+// Values generated during shrinking (e.g. simpler alternatives of [Weighted] generator) never alias.
+// Error is returned if generator's target is not a pointer or element generator returns an error.
+func Aliasing(aliased func(Alias) arbitrary.Generator, limits ...constraints.Alias) arbitrary.Generator {
+	constraint := constraints.AliasDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		// Pointers can be aliased only while the value is being generated, and
+		// values generated during shrinking (e.g. by Weighted) get new pointers.
+		pointers, generating := map[reflect.Type][]reflect.Value{}, true
+
+		// New pointers, whose values are set to the values of their elements during shrinking.
+		nodes := map[aliasKey]bool{}
+
+		alias := func(element arbitrary.Generator, limits ...constraints.Alias) arbitrary.Generator {
+			constraint := constraint
+			if len(limits) != 0 {
+				constraint = limits[0]
+			}
+
+			return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+				if target.Kind() != reflect.Ptr {
+					return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Alias")
+				}
+
+				previous := pointers[target]
+				if generating && len(previous) != 0 && constraint.Frequency != 0 && r.Uint64(constraints.Uint64{Min: 1, Max: constraint.Frequency})%constraint.Frequency == 0 {
+					return arbitrary.Arbitrary{
+						Value: previous[r.Uint64(constraints.Uint64{Min: 0, Max: uint64(len(previous) - 1)})],
+					}, nil
+				}
+
+				// Pointer is created before it's value is generated, so that it can be aliased
+				// by the pointers within the value.
+				pointer := reflect.New(target.Elem())
+				nodes[aliasKeyOf(pointer)] = true
+				if generating {
+					pointers[target] = append(previous, pointer)
+				}
+
+				element, err := element(target.Elem(), bias, r)
+				if err != nil {
+					return arbitrary.Arbitrary{}, fmt.Errorf("failed to generate value pointer: %s points to. %w", target, err)
+				}
+				pointer.Elem().Set(element.Value)
+
+				return aliasPointer(pointer, element), nil
+			}
+		}
+
+		arb, err := aliased(alias)(target, bias, r)
+		generating = false
+		if err != nil {
+			return arbitrary.Arbitrary{}, err
+		}
+
+		arb.Shrinker = arb.Shrinker.TransformAfter(aliasCommit(nodes))
+		return arb, nil
+	}
+}
+
+// aliasPointer returns arbitrary of a pointer generated by [Alias] generator, that points
+// to element's value. It's shrunk by shrinking the element, while the pointer stays the same.
+func aliasPointer(pointer reflect.Value, element arbitrary.Arbitrary) arbitrary.Arbitrary {
+	node := arbitrary.Arbitrary{
+		Value:    pointer,
+		Elements: arbitrary.Arbitraries{element},
+	}
+
+	if element.Shrinker != nil {
+		node.Shrinker = func(node arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+			if node.Elements[0].Shrinker == nil {
+				node.Shrinker = nil
+				return node, nil
+			}
+
+			shrink, err := node.Elements[0].Shrinker(node.Elements[0], propertyFailed)
+			if err != nil {
+				return arbitrary.Arbitrary{}, err
+			}
+			return aliasPointer(pointer, shrink), nil
+		}
+	}
+	return node
+}
+
+// aliasCommit returns a transformation that sets values of the pointers within arbitrary,
+// that are generated by [Alias] generators and are in "nodes", to the values of their
+// elements, so that all aliases of the pointers see the shrunk values. Pointers are
+// updated in place, as aliases share them.
+func aliasCommit(nodes map[aliasKey]bool) func(arbitrary.Arbitrary) arbitrary.Arbitrary {
+	var commit func(arbitrary.Arbitrary) arbitrary.Arbitrary
+	commit = func(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+		for _, element := range arb.Elements {
+			commit(element)
+		}
+		for _, precursor := range arb.Precursors {
+			commit(precursor)
+		}
+
+		if arb.Value.Kind() == reflect.Ptr && !arb.Value.IsNil() && len(arb.Elements) == 1 && nodes[aliasKeyOf(arb.Value)] {
+			arb.Value.Elem().Set(arb.Elements[0].Value)
+		}
+		return arb
+	}
+	return commit
+}
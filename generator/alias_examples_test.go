@@ -0,0 +1,43 @@
+package generator_test
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Aliasing() generator for generation of graphs, whose
+// nodes can point to any of the previously generated nodes, including themselves. Shared
+// nodes are encoded with an id (e.g. &1), and referenced by it afterwards (e.g. (&1)).
+func ExampleAliasing() {
+	type Node struct {
+		Value int
+		Edges []*Node
+	}
+
+	streamer := generator.Streamer(
+		func(node *Node) {
+			fmt.Println(arbitrary.EncodeToString(reflect.ValueOf(node)))
+		},
+		generator.Aliasing(func(alias generator.Alias) arbitrary.Generator {
+			return generator.Recursion(generator.Nil(), func(r generator.Recurse) arbitrary.Generator {
+				return alias(generator.Struct(map[string]arbitrary.Generator{
+					"Value": generator.Int(constraints.Int{Min: 0, Max: 9}),
+					"Edges": generator.Slice(r(), constraints.Length{Min: 0, Max: 2}),
+				}))
+			}, constraints.Recursive{Depth: 3, Frequency: 6})
+		}),
+	)
+
+	if err := generator.Stream(0, 4, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// <*generator_test.Node> <generator_test.Node> {"Value": <int> 2, "Edges": <[]*generator_test.Node> [(nil), (nil)]}
+	// <*generator_test.Node> <generator_test.Node> {"Value": <int> 3, "Edges": <[]*generator_test.Node> [(nil)]}
+	// <*generator_test.Node> <generator_test.Node> {"Value": <int> 2, "Edges": <[]*generator_test.Node> []}
+	// <*generator_test.Node> <generator_test.Node> {"Value": <int> 9, "Edges": <[]*generator_test.Node> []}
+}
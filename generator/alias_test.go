@@ -0,0 +1,209 @@
+package generator
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestAliasing(t *testing.T) {
+	type Node struct {
+		Value int
+		Next  *Node
+	}
+
+	list := func(limits ...constraints.Alias) arbitrary.Generator {
+		return Aliasing(func(alias Alias) arbitrary.Generator {
+			return Recursion(Nil(), func(r Recurse) arbitrary.Generator {
+				return alias(Struct(map[string]arbitrary.Generator{
+					"Value": Int(constraints.Int{Min: 0, Max: 10}),
+					"Next":  r(),
+				}))
+			}, constraints.Recursive{Depth: 10, Frequency: 10})
+		}, limits...)
+	}
+
+	// cycle returns the number of nodes in the cycle list ends with, or 0 if it has no cycle.
+	cycle := func(node *Node) int {
+		visited := map[*Node]int{}
+		for index := 0; node != nil; index, node = index+1, node.Next {
+			if previous, exists := visited[node]; exists {
+				return index - previous
+			}
+			visited[node] = index
+		}
+		return 0
+	}
+
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(int) {},
+				Aliasing(func(alias Alias) arbitrary.Generator {
+					return alias(Int())
+				}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidTarget, err)
+			}
+		},
+		"Cycles": func(t *testing.T) {
+			cycles := 0
+			err := Stream(0, 100, Streamer(
+				func(node *Node) {
+					if cycle(node) != 0 {
+						cycles++
+					}
+				},
+				list(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if cycles == 0 {
+				t.Fatalf("Expected some of the lists to have cycles")
+			}
+		},
+		"NoAliases": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(node *Node) {
+					if cycle(node) != 0 {
+						t.Fatalf("Unexpected cycle")
+					}
+				},
+				list(constraints.Alias{Frequency: 0}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"AlwaysAlias": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(node *Node) {
+					if node != nil && node.Next != nil && cycle(node) == 0 {
+						t.Fatalf("Expected list to be a cycle")
+					}
+				},
+				list(constraints.Alias{Frequency: 1}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"ShrinkToSelfLoop": func(t *testing.T) {
+			// Lists end with a node that aliases one of the previous nodes, thus every list
+			// with more than one node has a cycle.
+			cyclic := Aliasing(func(alias Alias) arbitrary.Generator {
+				node := func(next arbitrary.Generator) arbitrary.Generator {
+					return Struct(map[string]arbitrary.Generator{
+						"Value": Int(constraints.Int{Min: 0, Max: 10}),
+						"Next":  next,
+					})
+				}
+				return Recursion(alias(node(Nil()), constraints.Alias{Frequency: 1}), func(r Recurse) arbitrary.Generator {
+					return alias(node(r()), constraints.Alias{Frequency: 0})
+				})
+			})
+
+			shrunk := shrink(t, cyclic, reflect.TypeOf(&Node{}), func(v reflect.Value) bool {
+				return cycle(v.Interface().(*Node)) != 0
+			})
+
+			node := shrunk.Interface().(*Node)
+			if node.Next != node || node.Value != 0 {
+				t.Fatalf("Expected list to be shrunk to a node with value 0 that points to itself. Got: %s", arbitrary.EncodeToString(shrunk))
+			}
+		},
+		"ShrinkThroughMap": func(t *testing.T) {
+			// Pointer generated by Alias is a precursor of the mapped arbitrary, and it's
+			// value is still set to the shrunk value.
+			mapped := Aliasing(func(alias Alias) arbitrary.Generator {
+				return alias(Int(constraints.Int{Min: 0, Max: 100})).Map(func(pointer *int) *int {
+					return pointer
+				})
+			})
+
+			shrunk := shrinkArbitrary(t, mapped, reflect.TypeOf((*int)(nil)), func(v reflect.Value) bool {
+				return *v.Interface().(*int) >= 5
+			})
+
+			if value := *shrunk.Value.Interface().(*int); value != 5 {
+				t.Fatalf("Expected pointer to value 5. Got: %d", value)
+			}
+			if precursors := shrunk.Precursors[0].Precursors; len(precursors) != 0 {
+				t.Fatalf("Expected Alias arbitrary without precursors. Got: %d", len(precursors))
+			}
+		},
+		"ShrinkCyclicGraph": func(t *testing.T) {
+			type Vertex struct {
+				Value int
+				Edges []*Vertex
+			}
+
+			graph := Aliasing(func(alias Alias) arbitrary.Generator {
+				return Recursion(Nil(), func(r Recurse) arbitrary.Generator {
+					return alias(Struct(map[string]arbitrary.Generator{
+						"Value": Int(constraints.Int{Min: 0, Max: 1000}),
+						"Edges": Slice(r(), constraints.Length{Min: 0, Max: 2}),
+					}))
+				}, constraints.Recursive{Depth: 10, Frequency: 10})
+			}, constraints.Alias{Frequency: 2})
+
+			// sum returns the sum of values of all vertices reachable from the vertex.
+			sum := func(v reflect.Value) int {
+				visited, total := map[*Vertex]bool{}, 0
+				var visit func(*Vertex)
+				visit = func(vertex *Vertex) {
+					if vertex == nil || visited[vertex] {
+						return
+					}
+					visited[vertex], total = true, total+vertex.Value
+					for _, edge := range vertex.Edges {
+						visit(edge)
+					}
+				}
+				visit(v.Interface().(*Vertex))
+				return total
+			}
+
+			// Subtrees with back-references to vertices outside of them would leave those
+			// vertices unshrinkable, thus graph must always be shrunk to the sum of 151.
+			for seed := int64(0); seed < 50; seed++ {
+				r := arbitrary.RandomNumber{Rand: rand.New(rand.NewSource(seed))}
+				arb, err := graph(reflect.TypeOf(&Vertex{}), constraints.Bias{Size: 100, Scaling: 1}, r)
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if sum(arb.Value) <= 150 {
+					continue
+				}
+
+				last := arb
+				for propertyFailed := true; arb.Shrinker != nil; {
+					if arb, err = arb.Shrinker(arb, propertyFailed); err != nil {
+						t.Fatalf("Unexpected shrinking error: %s", err)
+					}
+					if propertyFailed = sum(arb.Value) > 150; propertyFailed {
+						last = arb
+					}
+				}
+
+				if total := sum(last.Value); total != 151 {
+					t.Fatalf("Expected graph to be shrunk to the sum of 151 for seed %d. Got: %s", seed, arbitrary.EncodeToString(last.Value))
+				}
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -1,17 +1,72 @@
 package generator
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
 	"reflect"
+	"time"
 
 	"github.com/steffnova/go-check/arbitrary"
 	"github.com/steffnova/go-check/constraints"
 )
 
+// standard returns generator for standard library types whose values can't be
+// generated based on their kind and bool value that indicates whether target is
+// one of those types.
+func standard(target reflect.Type) (arbitrary.Generator, bool) {
+	switch target {
+	case reflect.TypeOf(time.Time{}):
+		return Time(), true
+	case reflect.TypeOf(time.Duration(0)):
+		return Duration(), true
+	case reflect.TypeOf(time.UTC):
+		return Location(), true
+	case reflect.TypeOf((*big.Int)(nil)):
+		return BigInt(), true
+	case reflect.TypeOf((*big.Rat)(nil)):
+		return BigRat(), true
+	case reflect.TypeOf((*big.Float)(nil)):
+		return BigFloat(), true
+	case reflect.TypeOf(net.IP{}), reflect.TypeOf(netip.Addr{}):
+		return IP(), true
+	case reflect.TypeOf(netip.Prefix{}):
+		return Prefix(), true
+	case reflect.TypeOf((*url.URL)(nil)):
+		return URL(), true
+	case reflect.TypeOf(mail.Address{}):
+		return MailAddress(), true
+	case reflect.TypeOf(json.Number("")):
+		return JSONNumber(), true
+	case reflect.TypeOf((*context.Context)(nil)).Elem():
+		return Context(), true
+	default:
+		return nil, false
+	}
+}
+
 // Any returns generator with default constraints for a type specified by generator's target.
+// If a generator is registered for the target type (see [Register]) it is used instead.
+// Standard library types time.Time, time.Duration and *time.Location are generated with
+// [Time], [Duration] and [Location] generators respectively. Types *big.Int, *big.Rat,
+// *big.Float, net.IP, netip.Addr, netip.Prefix, *url.URL, mail.Address, json.Number and
+// context.Context are generated with [BigInt], [BigRat], [BigFloat], [IP], [IP], [Prefix],
+// [URL], [MailAddress], [JSONNumber] and [Context] generators respectively.
 // Unsupported target: interface{}
 func Any() arbitrary.Generator {
 	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if generator, exists := registered(target); exists {
+			return generator(target, bias, r)
+		}
+		if generator, exists := standard(target); exists {
+			return generator(target, bias, r)
+		}
+
 		var generator arbitrary.Generator
 		switch target.Kind() {
 		case reflect.Array:
@@ -28,14 +28,14 @@ func ExampleAny() {
 		panic(err)
 	}
 	// Output:
-	// -4518808235179270133, 7861855757602232086, generator_test.Point{X:5150, Y:-11651, Z:-40}
-	// -2122761628320059770, 16172318933975836041, generator_test.Point{X:31913, Y:-20516, Z:-56}
-	// -6485228379443441869, 9950499355085743487, generator_test.Point{X:31469, Y:16403, Z:-120}
-	// 5972778420317109720, 18356510534673821416, generator_test.Point{X:-30288, Y:-3364, Z:-95}
-	// 3277659493131553792, 16536373503259581585, generator_test.Point{X:-20183, Y:22302, Z:-117}
-	// 2889002371220678169, 9050008079631751930, generator_test.Point{X:-2582, Y:-12674, Z:-7}
-	// 1861954357100430827, 9301751449745155624, generator_test.Point{X:-16049, Y:12306, Z:74}
-	// -1080827893950765636, 4332503251610791914, generator_test.Point{X:24459, Y:2740, Z:109}
-	// -4610581452772180400, 11452572414278503431, generator_test.Point{X:3632, Y:-17110, Z:83}
-	// 260101872073892018, 17022257214824873781, generator_test.Point{X:-5456, Y:-13741, Z:-12}
+	// -5339971465336467958, 12088744466886928415, generator_test.Point{X:13142, Y:15828, Z:-91}
+	// -1543285579645681342, 14677457169740829639, generator_test.Point{X:4175, Y:1247, Z:-116}
+	// -300681375570251064, 5606570076237929230, generator_test.Point{X:-17391, Y:-25836, Z:-51}
+	// -2023352169218621252, 9491810378858993108, generator_test.Point{X:21880, Y:23449, Z:120}
+	// -7819249545370605693, 10732944964382368089, generator_test.Point{X:2272, Y:-30288, Z:-64}
+	// -6787183051953194503, 15169603299902489319, generator_test.Point{X:-26463, Y:-21294, Z:0}
+	// 9177598355735269079, 14220942032815928813, generator_test.Point{X:-26660, Y:17945, Z:25}
+	// 9050008079631751930, 16728535719694244940, generator_test.Point{X:-870, Y:-12674, Z:-128}
+	// -7056120859908864934, 1861954357100430827, generator_test.Point{X:15652, Y:-24979, Z:40}
+	// -4265511144525599390, 11116133554876932735, generator_test.Point{X:-12306, Y:9628, Z:-74}
 }
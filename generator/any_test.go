@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -29,6 +30,16 @@ func TestAny(t *testing.T) {
 				t.Fatalf("Unexpected error: %s", err)
 			}
 		},
+		"Context": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(context.Context) {},
+				Any(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
 		"Bool": func(t *testing.T) {
 			err := Stream(0, 100, Streamer(
 				func(bool) {},
@@ -22,16 +22,16 @@ func ExampleArray() {
 		panic(err)
 	}
 	// Output:
-	// [5]int{-4518808235179270133, -5036824528102830934, 8071137008395949086, -2122761628320059770, 6925466992496964832}
-	// [5]int{5606570076237929230, -6485228379443441869, -1089963290385541773, -315038161257240872, -8800248522230157011}
-	// [5]int{5309191430948421708, 8901768139528370850, -1284006505070580203, 3029607914297333936, -7505562437545936694}
-	// [5]int{9177598355735269079, 2353700405777826677, 2889002371220678169, 2430368660537815426, -5013668637975760780}
-	// [5]int{1861954357100430827, 3930565546038334149, 4265511144525599390, 9001397090059497490, -7811778195032818482}
-	// [5]int{3434633470139019496, 4491726675100777446, 7551152765542507822, 6992623394542015136, -4610581452772180400}
-	// [5]int{2983335422402563632, -3236400634926555689, -8318806587974000740, 7656290481659077236, -1073273973791335576}
-	// [5]int{1135614103155420740, -7031127273457604653, 2574016966663937194, 5790223617635911539, 8164215293865182704}
-	// [5]int{-6776706801480370880, -2849222499850869647, -8212568795191619498, -1767412366155247756, -6821913011751915842}
-	// [5]int{7534629738184250638, 1276965762818039701, -1600752837639152423, -6612685186773303684, -4142208901106344656}
+	// [5]int{-5339971465336467958, 5036824528102830934, 4435185786993720788, 8071137008395949086, 2122761628320059770}
+	// [5]int{-5365688832259816617, -300681375570251064, -6485228379443441869, -8468275846115330281, -1089963290385541773}
+	// [5]int{2727171422159354966, -315038161257240872, -660303368809814667, 5972778420317109720, 8502318506928285676}
+	// [5]int{1284006505070580203, -2247583555303968036, 7505562437545936694, 710940327224637099, 58744246291326318}
+	// [5]int{9177598355735269079, 4772086176229548406, -4788190396876772902, -3058895608739614131, 9050008079631751930}
+	// [5]int{2430368660537815426, 5013668637975760780, -7056120859908864934, -8862094245172592907, 4700561838446243300}
+	// [5]int{-5712649238675462878, 8746914360817110192, 325496396026881436, -3094703518683447370, -1080827893950765636}
+	// [5]int{-4332503251610791914, 7551152765542507822, 5648976390688527282, 4610581452772180400, 3974191382882571532}
+	// [5]int{2983335422402563632, 3236400634926555689, 260101872073892018, 8318806587974000740, -8405140618506968395}
+	// [5]int{7656290481659077236, 1073273973791335576, -331846068917293018, 1135614103155420740, 7031127273457604653}
 }
 
 // This example demonstrates usage of ArrayFrom() generator and Int() generator for generation
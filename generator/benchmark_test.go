@@ -0,0 +1,74 @@
+package generator
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func BenchmarkGenerate(b *testing.B) {
+	type record struct {
+		ID    uint64
+		Name  string
+		Score float64
+		Tags  []int8
+	}
+
+	benchmarks := map[string]struct {
+		generator arbitrary.Generator
+		target    reflect.Type
+	}{
+		"Int":    {Int(), reflect.TypeOf(0)},
+		"Int8":   {Int8(), reflect.TypeOf(int8(0))},
+		"Uint64": {Uint64(), reflect.TypeOf(uint64(0))},
+		"Float":  {Float64(), reflect.TypeOf(float64(0))},
+		"String": {String(), reflect.TypeOf("")},
+		"StringAssigned": {String(constraints.String{
+			Rune:   constraints.Rune{MinCodePoint: 0, MaxCodePoint: 0x10ffff, Assigned: true},
+			Length: constraints.LengthDefault(),
+		}), reflect.TypeOf("")},
+		"Slice":      {Slice(Int(), constraints.Length{Min: 0, Max: 100}), reflect.TypeOf([]int{})},
+		"Struct":     {Any(), reflect.TypeOf(record{})},
+		"SliceUint8": {Slice(Uint8(), constraints.Length{Min: 4096, Max: 4096}), reflect.TypeOf([]byte{})},
+		"Bytes":      {Bytes(constraints.Length{Min: 4096, Max: 4096}), reflect.TypeOf([]byte{})},
+	}
+
+	for name, benchmark := range benchmarks {
+		b.Run(name, func(b *testing.B) {
+			r := arbitrary.RandomNumber{Rand: rand.New(rand.NewSource(0))}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := benchmark.generator(benchmark.target, constraints.Bias{Size: 100, Scaling: 1}, r); err != nil {
+					b.Fatalf("Unexpected error: %s", err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkShrink(b *testing.B) {
+	benchmarks := map[string]struct {
+		generator arbitrary.Generator
+		target    reflect.Type
+	}{
+		"Int":        {Int(), reflect.TypeOf(0)},
+		"Slice":      {Slice(Int(), constraints.Length{Min: 50, Max: 100}), reflect.TypeOf([]int{})},
+		"Map":        {Map(Int16(), Bool(), constraints.Length{Min: 50, Max: 100}), reflect.TypeOf(map[int16]bool{})},
+		"SliceUint8": {Slice(Uint8(), constraints.Length{Min: 1024, Max: 1024}), reflect.TypeOf([]byte{})},
+		"Bytes":      {Bytes(constraints.Length{Min: 1024, Max: 1024}), reflect.TypeOf([]byte{})},
+	}
+
+	for name, benchmark := range benchmarks {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				shrink(b, benchmark.generator, benchmark.target, func(reflect.Value) bool {
+					return true
+				})
+			}
+		})
+	}
+}
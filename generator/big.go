@@ -0,0 +1,121 @@
+package generator
+
+import (
+	"encoding/binary"
+	"math/big"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// bigIntParts is an intermediate representation of big.Int value. Words are 64 bit
+// words of value's magnitude, starting with the least significant one.
+type bigIntParts struct {
+	Negative bool
+	Words    []uint64
+}
+
+// bigRatParts is an intermediate representation of big.Rat value.
+type bigRatParts struct {
+	Numerator   *big.Int
+	Denominator *big.Int
+}
+
+// bigFloatParts is an intermediate representation of big.Float value, whose value
+// is Mantissa × 2^Exponent.
+type bigFloatParts struct {
+	Mantissa *big.Int
+	Exponent int32
+}
+
+// bigIntWords is the default range of the number of 64 bit words of big.Int's magnitude.
+var bigIntWords = constraints.Length{Min: 0, Max: 4}
+
+// BigInt returns generator for *big.Int type. Magnitude of generated values is defined by
+// "limits" parameter, which defines the range of the number of 64 bit words the magnitude
+// consists of. If no limits are provided magnitude has at most 4 words (256 bits). Generated
+// values are shrunk towards 0 by removing words of magnitude and shrinking each of them, and
+// negative values are shrunk towards positive ones. Error is returned if generator's target
+// is not *big.Int or limits.Min is greater than limits.Max.
+func BigInt(limits ...constraints.Length) arbitrary.Generator {
+	constraint := bigIntWords
+	if len(limits) > 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf((*big.Int)(nil)) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "BigInt")
+		}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(bigIntParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(bigIntParts)
+			bytes := make([]byte, 8*len(parts.Words))
+			for index, word := range parts.Words {
+				binary.BigEndian.PutUint64(bytes[len(bytes)-8*(index+1):], word)
+			}
+
+			n := new(big.Int).SetBytes(bytes)
+			if parts.Negative {
+				n.Neg(n)
+			}
+			return reflect.ValueOf(n)
+		})
+
+		return Struct(map[string]arbitrary.Generator{
+			"Negative": Bool(),
+			"Words":    Slice(Uint64(), constraint),
+		}).Map(mapper)(target, bias, r)
+	}
+}
+
+// BigRat returns generator for *big.Rat type. Numerator is generated with [BigInt] generator
+// using "limits" parameter, and denominator is a positive value generated with the same
+// generator. Generated values are shrunk towards 0 by shrinking numerator towards 0 and
+// denominator towards 1. Error is returned if generator's target is not *big.Rat or
+// limits.Min is greater than limits.Max.
+func BigRat(limits ...constraints.Length) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf((*big.Rat)(nil)) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "BigRat")
+		}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(bigRatParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(bigRatParts)
+			denominator := new(big.Int).Abs(parts.Denominator)
+			denominator.Add(denominator, big.NewInt(1))
+			return reflect.ValueOf(new(big.Rat).SetFrac(parts.Numerator, denominator))
+		})
+
+		return Struct(map[string]arbitrary.Generator{
+			"Numerator":   BigInt(limits...),
+			"Denominator": BigInt(limits...),
+		}).Map(mapper)(target, bias, r)
+	}
+}
+
+// BigFloat returns generator for *big.Float type. Generated value is mantissa × 2^exponent,
+// where mantissa is generated with [BigInt] generator using "limits" parameter, and exponent
+// is in range [-1024, 1024]. Precision of generated value is the bit length of mantissa, but
+// at least 64 bits. Generated values are shrunk towards 0 by shrinking mantissa towards 0
+// and exponent towards 0. Error is returned if generator's target is not *big.Float or
+// limits.Min is greater than limits.Max.
+func BigFloat(limits ...constraints.Length) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf((*big.Float)(nil)) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "BigFloat")
+		}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(bigFloatParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(bigFloatParts)
+			f := new(big.Float).SetInt(parts.Mantissa)
+			return reflect.ValueOf(f.SetMantExp(f, int(parts.Exponent)))
+		})
+
+		return Struct(map[string]arbitrary.Generator{
+			"Mantissa": BigInt(limits...),
+			"Exponent": Int32(constraints.Int32{Min: -1024, Max: 1024}),
+		}).Map(mapper)(target, bias, r)
+	}
+}
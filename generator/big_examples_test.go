@@ -0,0 +1,50 @@
+package generator_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use BigInt() generator for generation of *big.Int values,
+// whose magnitude has at most two 64 bit words.
+func ExampleBigInt() {
+	streamer := generator.Streamer(
+		func(n *big.Int) {
+			fmt.Println(n)
+		},
+		generator.BigInt(constraints.Length{Min: 0, Max: 2}),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// -145025641104908291884643638912454814224
+	// 270751296063042417554002846310772260648
+	// 0
+	// -98979488667858296559990779357395354847
+	// 0
+}
+
+// This example demonstrates how to use BigRat() generator for generation of *big.Rat values.
+func ExampleBigRat() {
+	streamer := generator.Streamer(
+		func(r *big.Rat) {
+			fmt.Println(r)
+		},
+		generator.BigRat(constraints.Length{Min: 0, Max: 1}),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// 0/1
+	// 2119085704421221023/14677457169740829640
+	// 0/1
+	// -5365688832259816617/1
+	// 0/1
+}
@@ -0,0 +1,153 @@
+package generator
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestBigInt(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(big.Int) {},
+				BigInt(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(*big.Int) {},
+				BigInt(constraints.Length{Min: 3, Max: 2}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"WithinLimits": func(t *testing.T) {
+			negative := false
+			err := Stream(0, 100, Streamer(
+				func(n *big.Int) {
+					if n.BitLen() > 128 {
+						t.Fatalf("Value %s has more than 128 bits", n)
+					}
+					negative = negative || n.Sign() < 0
+				},
+				BigInt(constraints.Length{Min: 1, Max: 2}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !negative {
+				t.Fatalf("Expected negative values to be generated")
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			threshold := new(big.Int).Lsh(big.NewInt(1), 70)
+			shrunk := shrink(t, BigInt(), reflect.TypeOf((*big.Int)(nil)), func(v reflect.Value) bool {
+				return new(big.Int).Abs(v.Interface().(*big.Int)).Cmp(threshold) >= 0
+			})
+
+			if n := shrunk.Interface().(*big.Int); n.Cmp(threshold) != 0 {
+				t.Fatalf("Expected value to be shrunk to: %s. Got: %s", threshold, n)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
+
+func TestBigRat(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(*big.Int) {},
+				BigRat(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"ValidValues": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(r *big.Rat) {
+					if r.Denom().Sign() <= 0 {
+						t.Fatalf("Invalid denominator: %s", r.Denom())
+					}
+				},
+				BigRat(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, BigRat(), reflect.TypeOf((*big.Rat)(nil)), func(v reflect.Value) bool {
+				return !v.Interface().(*big.Rat).IsInt()
+			})
+
+			if r := shrunk.Interface().(*big.Rat); r.Cmp(big.NewRat(1, 2)) != 0 {
+				t.Fatalf("Expected value to be shrunk to: 1/2. Got: %s", r)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
+
+func TestBigFloat(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(float64) {},
+				BigFloat(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"ValidValues": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(f *big.Float) {
+					if f.IsInf() {
+						t.Fatalf("Unexpected infinite value")
+					}
+				},
+				BigFloat(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, BigFloat(), reflect.TypeOf((*big.Float)(nil)), func(v reflect.Value) bool {
+				return v.Interface().(*big.Float).Sign() < 0
+			})
+
+			if f := shrunk.Interface().(*big.Float); f.Cmp(big.NewFloat(-1)) != 0 {
+				t.Fatalf("Expected value to be shrunk to: -1. Got: %s", f)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
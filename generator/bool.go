@@ -15,13 +15,13 @@ func Bool() arbitrary.Generator {
 		case target.Kind() != reflect.Bool:
 			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Bool")
 		default:
-			mapper := arbitrary.Mapper(reflect.TypeOf(uint64(0)), target, func(in reflect.Value) reflect.Value {
+			convertFn := func(in reflect.Value) reflect.Value {
 				return reflect.ValueOf(in.Uint() != 0).Convert(target)
-			})
-			return Uint64(constraints.Uint64{
+			}
+			return convert(Uint64(constraints.Uint64{
 				Min: 0,
 				Max: 1,
-			}).Map(mapper)(target, bias, r)
+			}), reflect.TypeOf(uint64(0)), convertFn)(target, bias, r)
 		}
 	}
 }
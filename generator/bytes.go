@@ -0,0 +1,49 @@
+package generator
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
+)
+
+// Bytes returns generator for byte slice types. Range of slice length is defined by "limits"
+// parameter. If "limits" parameter is not specified default [0, 100] range is used instead.
+// Unlike Slice(Uint8()), generated slice is a single arbitrary whose bytes are drawn 8 at
+// a time, which makes generation and shrinking of large payloads efficient. Generated slices
+// are shrunk with [shrinker.Bytes]: by removing chunks of bytes and then shrinking each byte
+// towards 0. Error is returned if generator's target is not a slice of bytes, or limits.Min
+// is greater than limits.Max.
+func Bytes(limits ...constraints.Length) arbitrary.Generator {
+	constraint := constraints.LengthDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		switch {
+		case target.Kind() != reflect.Slice || target.Elem().Kind() != reflect.Uint8:
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Bytes")
+		case constraint.Min > constraint.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal length value %d can't be greater than max length value %d", arbitrary.ErrorInvalidConstraints, constraint.Min, constraint.Max)
+		case constraint.Max > uint64(math.MaxInt64):
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Max length %d can't be greater than %d", arbitrary.ErrorInvalidConstraints, constraint.Max, uint64(math.MaxInt64))
+		}
+
+		bytes := make([]byte, r.Uint64(constraints.Uint64(constraint)))
+		word := [8]byte{}
+		for index := 0; index < len(bytes); index += len(word) {
+			binary.LittleEndian.PutUint64(word[:], r.Uint64(constraints.Uint64Default()))
+			copy(bytes[index:], word[:])
+		}
+
+		return arbitrary.Arbitrary{
+			Value:    reflect.ValueOf(bytes).Convert(target),
+			Shrinker: shrinker.Bytes(constraint),
+		}, nil
+	}
+}
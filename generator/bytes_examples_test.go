@@ -0,0 +1,28 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Bytes() generator for generation of byte slices.
+func ExampleBytes() {
+	streamer := generator.Streamer(
+		func(data []byte) {
+			fmt.Printf("%x\n", data)
+		},
+		generator.Bytes(constraints.Length{Min: 2, Max: 8}),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// f2bfeca7557ae9
+	// d0bf
+	// 16973d
+	// c0c7e266a1
+	// 9fa2a28438
+}
@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestBytes(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]int8) {},
+				Bytes(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]byte) {},
+				Bytes(constraints.Length{Min: 10, Max: 5}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"WithinLimits": func(t *testing.T) {
+			type payload []byte
+			err := Stream(0, 100, Streamer(
+				func(data payload) {
+					if len(data) < 5 || len(data) > 20 {
+						t.Fatalf("Invalid length: %d", len(data))
+					}
+				},
+				Bytes(constraints.Length{Min: 5, Max: 20}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, Bytes(constraints.Length{Min: 3, Max: 1000}), reflect.TypeOf([]byte{}), func(v reflect.Value) bool {
+				for _, b := range v.Bytes() {
+					if b >= 100 {
+						return true
+					}
+				}
+				return false
+			})
+
+			if data := shrunk.Bytes(); len(data) != 3 || int(data[0])+int(data[1])+int(data[2]) != 100 {
+				t.Fatalf("Expected bytes to be shrunk to 3 bytes with a single byte equal to 100. Got: %v", data)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
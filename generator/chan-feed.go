@@ -0,0 +1,158 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// chanSend is an intermediate representation of a value sent to the channel after a delay.
+type chanSend struct {
+	Value interface{}
+	Delay time.Duration
+}
+
+// chanFeedParts is an intermediate representation of a fed channel.
+type chanFeedParts struct {
+	Sends      []chanSend
+	Buffer     uint64
+	CloseDelay time.Duration
+	Open       bool
+}
+
+// ChanFeed returns generator for chan and <-chan types, whose values are sent to the channel
+// over time by a separate goroutine. Values are generated by "element" generator, and each
+// value is sent after a generated delay. Once all values are sent, channel is closed after
+// a generated delay, or it's left open if limits.LeaveOpen is true (in which case receiving
+// from it eventually blocks forever). Number of values, buffer size and delays are defined
+// by "limits" parameter. The "limits" parameter, even though it is variadic, evaluates only
+// the first instance of [constraints.ChanFeed]. If limits are omitted, [constraints.ChanFeedDefault]
+// is used instead. Channels are shrunk towards fewer values, shorter delays, smaller buffer
+// size and towards being closed. A fresh channel is created for each predicate run (see
+// [arbitrary.Arbitrary] Feed), and goroutine that feeds it stops once the predicate returns,
+// after which values that were not received are dropped and the channel is left open. Value
+// of the generated arbitrary is a channel that already holds all the values, and is closed
+// unless it's left open. Error is returned if generator's target is not chan or <-chan type,
+// limits are invalid or element generator returns an error.
+func ChanFeed(element arbitrary.Generator, limits ...constraints.ChanFeed) arbitrary.Generator {
+	constraint := constraints.ChanFeedDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		switch {
+		case target.Kind() != reflect.Chan || target.ChanDir() == reflect.SendDir:
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "ChanFeed")
+		case constraint.Values.Min > constraint.Values.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal number of values %d can't be greater than max number of values %d", arbitrary.ErrorInvalidConstraints, constraint.Values.Min, constraint.Values.Max)
+		case constraint.Buffer.Min > constraint.Buffer.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal buffer size %d can't be greater than max buffer size %d", arbitrary.ErrorInvalidConstraints, constraint.Buffer.Min, constraint.Buffer.Max)
+		case constraint.Buffer.Max > uint64(math.MaxInt64):
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Max buffer size %d can't be greater than %d", arbitrary.ErrorInvalidConstraints, constraint.Buffer.Max, uint64(math.MaxInt64))
+		case constraint.MaxDelay < 0:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Max delay %s can't be negative", arbitrary.ErrorInvalidConstraints, constraint.MaxDelay)
+		}
+
+		delay := Duration(constraints.Duration{Min: 0, Max: constraint.MaxDelay})
+		open := Constant(false)
+		if constraint.LeaveOpen {
+			open = Bool()
+		}
+
+		// Value of the arbitrary is a channel that already holds all the values, while each
+		// use of the arbitrary gets a fresh channel fed with values over time.
+		mapper := arbitrary.Mapper(reflect.TypeOf(chanFeedParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(chanFeedParts)
+			channel := reflect.MakeChan(reflect.ChanOf(reflect.BothDir, target.Elem()), len(parts.Sends))
+			for _, value := range chanFeedValues(target, parts) {
+				channel.Send(value)
+			}
+			if !parts.Open {
+				channel.Close()
+			}
+			return channel.Convert(target)
+		})
+
+		fed := func(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+			parts := arb.Precursors[0].Value.Interface().(chanFeedParts)
+			arb.Feed = func(done <-chan struct{}) reflect.Value {
+				return chanFeed(target, parts, done)
+			}
+			return arb
+		}
+
+		arb, err := Struct(map[string]arbitrary.Generator{
+			"Sends": Slice(Struct(map[string]arbitrary.Generator{
+				"Value": wrappedValue(target.Elem(), element),
+				"Delay": delay,
+			}), constraint.Values),
+			"Buffer":     Uint64(constraints.Uint64(constraint.Buffer)),
+			"CloseDelay": delay,
+			"Open":       open,
+		}).Map(mapper)(target, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, err
+		}
+
+		arb.Shrinker = arb.Shrinker.TransformAfter(fed)
+		return fed(arb), nil
+	}
+}
+
+// chanFeedValues returns values that are sent to the channel described by "parts".
+func chanFeedValues(target reflect.Type, parts chanFeedParts) []reflect.Value {
+	values := make([]reflect.Value, len(parts.Sends))
+	for index, send := range parts.Sends {
+		values[index] = reflect.ValueOf(send.Value)
+		if !values[index].IsValid() {
+			values[index] = reflect.Zero(target.Elem())
+		}
+	}
+	return values
+}
+
+// chanFeed creates a channel described by "parts" and starts a goroutine that feeds it. The
+// goroutine stops once "done" is closed, dropping the values that were not received.
+func chanFeed(target reflect.Type, parts chanFeedParts, done <-chan struct{}) reflect.Value {
+	channel := reflect.MakeChan(reflect.ChanOf(reflect.BothDir, target.Elem()), int(parts.Buffer))
+	values := chanFeedValues(target, parts)
+
+	go func() {
+		for index, send := range parts.Sends {
+			if !chanFeedSleep(send.Delay, done) {
+				return
+			}
+			chosen, _, _ := reflect.Select([]reflect.SelectCase{
+				{Dir: reflect.SelectSend, Chan: channel, Send: values[index]},
+				{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(done)},
+			})
+			if chosen == 1 {
+				return
+			}
+		}
+		if !parts.Open && chanFeedSleep(parts.CloseDelay, done) {
+			channel.Close()
+		}
+	}()
+
+	return channel.Convert(target)
+}
+
+// chanFeedSleep waits for "delay" to pass and returns true, or returns false if "done"
+// is closed before that.
+func chanFeedSleep(delay time.Duration, done <-chan struct{}) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-done:
+		return false
+	}
+}
@@ -0,0 +1,33 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use ChanFeed() generator for generation of channels
+// whose values are sent over time. Channel is closed after all values are sent.
+func ExampleChanFeed() {
+	streamer := generator.Streamer(
+		func(ch <-chan int) {
+			ns := []int{}
+			for n := range ch {
+				ns = append(ns, n)
+			}
+			fmt.Println(ns)
+		},
+		generator.ChanFeed(generator.Int(constraints.Int{Min: 0, Max: 100})),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// [31 80 69 84 64]
+	// [31]
+	// [71 57 79 95 69]
+	// [9 96 67 23]
+	// [14 64 77 23 15 93 34 13]
+}
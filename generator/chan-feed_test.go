@@ -0,0 +1,143 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestChanFeed(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			streamers := []streamer{
+				Streamer(func([]int) {}, ChanFeed(Int())),
+				Streamer(func(chan<- int) {}, ChanFeed(Int())),
+			}
+
+			for _, streamer := range streamers {
+				if err := Stream(0, 10, streamer); !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+					t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidTarget, err)
+				}
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			for _, limits := range []constraints.ChanFeed{
+				{Values: constraints.Length{Min: 2, Max: 1}},
+				{Buffer: constraints.Length{Min: 2, Max: 1}},
+				{Buffer: constraints.Length{Min: 0, Max: 1 << 63}},
+				{MaxDelay: -time.Millisecond},
+			} {
+				err := Stream(0, 10, Streamer(func(<-chan int) {}, ChanFeed(Int(), limits)))
+				if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+					t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidConstraints, err)
+				}
+			}
+		},
+		"ValuesWithinLimits": func(t *testing.T) {
+			limits := constraints.ChanFeed{
+				Values:   constraints.Length{Min: 2, Max: 5},
+				Buffer:   constraints.Length{Min: 0, Max: 3},
+				MaxDelay: time.Millisecond,
+			}
+
+			err := Stream(0, 100, Streamer(
+				func(ch <-chan int) {
+					if cap(ch) > 3 {
+						t.Fatalf("Channel's buffer size %d is out of range [0, 3]", cap(ch))
+					}
+					n := 0
+					for value := range ch {
+						if value < 0 || value > 10 {
+							t.Fatalf("Value %d is out of range [0, 10]", value)
+						}
+						n++
+					}
+					if n < 2 || n > 5 {
+						t.Fatalf("Number of values %d is out of range [2, 5]", n)
+					}
+				},
+				ChanFeed(Int(constraints.Int{Min: 0, Max: 10}), limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"LeaveOpen": func(t *testing.T) {
+			limits := constraints.ChanFeedDefault()
+			limits.Values = constraints.Length{Min: 1, Max: 1}
+			limits.LeaveOpen = true
+
+			open, closed := 0, 0
+			err := Stream(0, 20, Streamer(
+				func(ch chan bool) {
+					<-ch
+					select {
+					case _, ok := <-ch:
+						if ok {
+							t.Fatalf("Unexpected value received")
+						}
+						closed++
+					case <-time.After(20 * time.Millisecond):
+						open++
+					}
+				},
+				ChanFeed(Bool(), limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if open == 0 || closed == 0 {
+				t.Fatalf("Expected both open and closed channels. Got: %d open and %d closed", open, closed)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			var received []int
+			shrink(t, ChanFeed(Int()), reflect.TypeOf((<-chan int)(nil)), func(v reflect.Value) bool {
+				values := []int{}
+				for value := range v.Interface().(<-chan int) {
+					values = append(values, value)
+				}
+				if len(values) < 3 {
+					return false
+				}
+				received = values
+				return true
+			})
+
+			if !reflect.DeepEqual(received, []int{0, 0, 0}) {
+				t.Fatalf("Expected values to be shrunk to [0 0 0]. Got: %v", received)
+			}
+		},
+		"FeedStopsGoroutines": func(t *testing.T) {
+			limits := constraints.ChanFeed{
+				Values:   constraints.Length{Min: 5, Max: 10},
+				MaxDelay: time.Millisecond,
+			}
+			before := runtime.NumGoroutine()
+
+			// Only the first value is received, leaving the rest of the values unreceived.
+			err := Stream(0, 20, Streamer(func(ch <-chan int) { <-ch }, ChanFeed(Int(), limits)))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before; {
+				if time.Now().After(deadline) {
+					t.Fatalf("Expected at most %d goroutines after streaming. Got: %d", before, runtime.NumGoroutine())
+				}
+				time.Sleep(time.Millisecond)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
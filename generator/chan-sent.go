@@ -0,0 +1,137 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// Sent collects values that code under test sends to a channel. Values are received by
+// a separate goroutine, whose buffer size and receive delays are generated by [ChanSent]
+// generator. Goroutine is started once the channel is used, and it's stopped once the
+// next shrink candidate is generated. Sent must be created with ChanSent generator.
+type Sent[T any] struct {
+	ch      chan T
+	values  []T
+	delays  []time.Duration
+	stop    <-chan struct{}
+	done    chan struct{}
+	started sync.Once
+	closed  sync.Once
+}
+
+// Chan returns the channel that code under test sends values to.
+func (s *Sent[T]) Chan() chan<- T {
+	s.started.Do(s.receive)
+	return s.ch
+}
+
+// Close closes the channel, waits until all values sent to it are received and returns
+// them in the order they were received. Close can be called multiple times, but values
+// must not be sent to the channel after the first call.
+func (s *Sent[T]) Close() []T {
+	s.started.Do(s.receive)
+	s.closed.Do(func() {
+		close(s.ch)
+	})
+	<-s.done
+	return s.values
+}
+
+// init creates the channel with buffer size specified by "buffer" parameter. The n-th
+// receive is delayed by n-th of the "delays", while the rest of the receives are not
+// delayed. Receiving stops once "stop" is closed.
+func (s *Sent[T]) init(buffer int, delays []time.Duration, stop <-chan struct{}) {
+	s.ch = make(chan T, buffer)
+	s.delays = delays
+	s.stop = stop
+	s.done = make(chan struct{})
+}
+
+// receive starts the goroutine that receives values from the channel.
+func (s *Sent[T]) receive() {
+	go func() {
+		defer close(s.done)
+		for index := 0; ; index++ {
+			if index < len(s.delays) && !chanFeedSleep(s.delays[index], s.stop) {
+				return
+			}
+			select {
+			case value, ok := <-s.ch:
+				if !ok {
+					return
+				}
+				s.values = append(s.values, value)
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// sentCollector is implemented by *Sent[T] types.
+type sentCollector interface {
+	init(buffer int, delays []time.Duration, stop <-chan struct{})
+}
+
+// chanSentParts is an intermediate representation of [Sent] value.
+type chanSentParts struct {
+	Buffer uint64
+	Delays []time.Duration
+}
+
+// ChanSent returns generator for *[Sent] types, that are used for verifying values code
+// under test sends to a channel (chan<- T). Generated Sent receives the values with
+// channel's buffer size and delays between receives defined by "limits" parameter. The
+// "limits" parameter, even though it is variadic, evaluates only the first instance of
+// [constraints.ChanSent]. If limits are omitted, [constraints.ChanSentDefault] is used
+// instead. Generated values are shrunk towards smaller buffer size and fewer and shorter
+// delays. Error is returned if generator's target is not *Sent[T] type or limits are
+// invalid.
+func ChanSent(limits ...constraints.ChanSent) arbitrary.Generator {
+	constraint := constraints.ChanSentDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		switch {
+		case target.Kind() != reflect.Ptr || !target.Implements(reflect.TypeOf((*sentCollector)(nil)).Elem()):
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "ChanSent")
+		case constraint.Buffer.Min > constraint.Buffer.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal buffer size %d can't be greater than max buffer size %d", arbitrary.ErrorInvalidConstraints, constraint.Buffer.Min, constraint.Buffer.Max)
+		case constraint.Buffer.Max > uint64(math.MaxInt64):
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Max buffer size %d can't be greater than %d", arbitrary.ErrorInvalidConstraints, constraint.Buffer.Max, uint64(math.MaxInt64))
+		case constraint.Delays.Min > constraint.Delays.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal number of delays %d can't be greater than max number of delays %d", arbitrary.ErrorInvalidConstraints, constraint.Delays.Min, constraint.Delays.Max)
+		case constraint.MaxDelay < 0:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Max delay %s can't be negative", arbitrary.ErrorInvalidConstraints, constraint.MaxDelay)
+		}
+
+		// Each shrink candidate stops the goroutine of the previous one, as the property is
+		// done with it by the time the next candidate is created.
+		stop := func() {}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(chanSentParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(chanSentParts)
+
+			stop()
+			done := make(chan struct{})
+			stop = func() { close(done) }
+
+			sent := reflect.New(target.Elem())
+			sent.Interface().(sentCollector).init(int(parts.Buffer), parts.Delays, done)
+			return sent
+		})
+
+		return Struct(map[string]arbitrary.Generator{
+			"Buffer": Uint64(constraints.Uint64(constraint.Buffer)),
+			"Delays": Slice(Duration(constraints.Duration{Min: 0, Max: constraint.MaxDelay}), constraint.Delays),
+		}).Map(mapper)(target, bias, r)
+	}
+}
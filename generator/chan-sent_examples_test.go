@@ -0,0 +1,33 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use ChanSent() generator for verifying values sent
+// to a channel. Values are received with generated buffer size and receive delays.
+func ExampleChanSent() {
+	produce := func(ch chan<- string, words ...string) {
+		for _, word := range words {
+			ch <- word
+		}
+	}
+
+	streamer := generator.Streamer(
+		func(sent *generator.Sent[string]) {
+			produce(sent.Chan(), "foo", "bar", "baz")
+			fmt.Println(sent.Close())
+		},
+		generator.ChanSent(),
+	)
+
+	if err := generator.Stream(0, 3, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// [foo bar baz]
+	// [foo bar baz]
+	// [foo bar baz]
+}
@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestChanSent(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			streamers := []streamer{
+				Streamer(func(chan<- int) {}, ChanSent()),
+				Streamer(func(**Sent[int]) {}, ChanSent()),
+				Streamer(func(*int) {}, ChanSent()),
+			}
+
+			for _, streamer := range streamers {
+				if err := Stream(0, 10, streamer); !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+					t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidTarget, err)
+				}
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			for _, limits := range []constraints.ChanSent{
+				{Buffer: constraints.Length{Min: 2, Max: 1}},
+				{Buffer: constraints.Length{Min: 0, Max: 1 << 63}},
+				{Delays: constraints.Length{Min: 2, Max: 1}},
+				{MaxDelay: -time.Millisecond},
+			} {
+				err := Stream(0, 10, Streamer(func(*Sent[int]) {}, ChanSent(limits)))
+				if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+					t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidConstraints, err)
+				}
+			}
+		},
+		"CollectsSentValues": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(sent *Sent[int], values []int) {
+					for _, value := range values {
+						sent.Chan() <- value
+					}
+					if received := sent.Close(); len(received) != len(values) || (len(values) != 0 && !reflect.DeepEqual(received, values)) {
+						t.Fatalf("Expected received values to be %v. Got: %v", values, received)
+					}
+					sent.Close()
+				},
+				ChanSent(),
+				Slice(Int()),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, ChanSent(), reflect.TypeOf((*Sent[int])(nil)), func(v reflect.Value) bool {
+				v.Interface().(*Sent[int]).Close()
+				return true
+			})
+
+			if sent := shrunk.Interface().(*Sent[int]); cap(sent.Chan()) != 0 {
+				t.Fatalf("Expected channel to be shrunk to unbuffered. Got buffer size: %d", cap(sent.Chan()))
+			}
+		},
+		"ShrinkStopsGoroutines": func(t *testing.T) {
+			before := runtime.NumGoroutine()
+
+			// Channel is used, but it's never closed.
+			shrink(t, ChanSent(), reflect.TypeOf((*Sent[int])(nil)), func(v reflect.Value) bool {
+				v.Interface().(*Sent[int]).Chan()
+				return true
+			})
+
+			// Goroutine of the last shrink candidate is still running.
+			for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before+1; {
+				if time.Now().After(deadline) {
+					t.Fatalf("Expected at most %d goroutines after shrinking. Got: %d", before+1, runtime.NumGoroutine())
+				}
+				time.Sleep(time.Millisecond)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
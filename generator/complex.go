@@ -27,14 +27,14 @@ func Complex128(limits ...constraints.Complex128) arbitrary.Generator {
 		case constraint.Imaginary.Min > constraint.Imaginary.Max:
 			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Lower limit of complex's imaginary part can't be higher that it's upper limit", arbitrary.ErrorInvalidConstraints)
 		default:
-			mapper := arbitrary.Mapper(reflect.TypeOf([2]float64{}), target, func(in reflect.Value) reflect.Value {
+			convertFn := func(in reflect.Value) reflect.Value {
 				parts := in.Interface().([2]float64)
 				return reflect.ValueOf(complex(parts[0], parts[1])).Convert(target)
-			})
-			return ArrayFrom(
+			}
+			return convert(ArrayFrom(
 				Float64(constraint.Real),
 				Float64(constraint.Imaginary),
-			).Map(mapper)(target, bias, r)
+			), reflect.TypeOf([2]float64{}), convertFn)(target, bias, r)
 		}
 	}
 }
@@ -58,14 +58,14 @@ func Complex64(limits ...constraints.Complex64) arbitrary.Generator {
 		case constraint.Imaginary.Min > constraint.Imaginary.Max:
 			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Lower limit of complex's imaginary part can't be higher that it's upper limit", arbitrary.ErrorInvalidConstraints)
 		default:
-			mapper := arbitrary.Mapper(reflect.TypeOf([2]float32{}), target, func(in reflect.Value) reflect.Value {
+			convertFn := func(in reflect.Value) reflect.Value {
 				parts := in.Interface().([2]float32)
 				return reflect.ValueOf(complex(parts[0], parts[1])).Convert(target)
-			})
-			return ArrayFrom(
+			}
+			return convert(ArrayFrom(
 				Float32(constraint.Real),
 				Float32(constraint.Imaginary),
-			).Map(mapper)(target, bias, r)
+			), reflect.TypeOf([2]float32{}), convertFn)(target, bias, r)
 		}
 	}
 }
@@ -20,16 +20,16 @@ func ExampleComplex64() {
 		panic(err)
 	}
 	// Output:
-	// (-2.4597852e+30-2.84718e-09i)
-	// (3.9155332e-22+5.336489e+16i)
-	// (5.3546978e+23-6.6470676e-23i)
-	// (2.0516037e-32+6.878372e+15i)
-	// (-2.5820768e+34-7.02896e-34i)
-	// (0.42347318-3.3288446e+30i)
-	// (0.0015746137-1.0883707e+20i)
-	// (-7812.692+5.0595798e+33i)
-	// (-1.0232254e+22-1.7621445e-22i)
-	// (-6.7646325e-35-1.2282892e-32i)
+	// (6.5711266e-15-2.84718e-09i)
+	// (1.841146e+06+3.9155332e-22i)
+	// (1.0390683e+06-2.3398438e-37i)
+	// (-5.3546978e+23+6.6470676e-23i)
+	// (-1.5922673e+10+2.0516037e-32i)
+	// (17.030838+1.0331481e+27i)
+	// (-1.9262444e-32-7.02896e-34i)
+	// (3.8865208e-32+0.42347318i)
+	// (3.3288446e+30-1.1897855e+38i)
+	// (0.0015746137+1.0883707e+20i)
 }
 
 // This example demonstrates usage of Complex64() generator with constraints for generation of complex64 values.
@@ -80,16 +80,16 @@ func ExampleComplex128() {
 		panic(err)
 	}
 	// Output:
-	// (-1.3131993411626801e-06+2.6499394281062435e-102i)
-	// (-5.544832151478103e+28-1.6925393061358905e+61i)
-	// (-1.3148118989728537e-70-4.579610072238924e+81i)
-	// (-3.1950849453106405e+135+1.6727313548317884e-205i)
-	// (2.78249623188919e+86+5.04589615041297e+124i)
-	// (9.142337453567358e-167-1.374619546296366e+49i)
-	// (-4.1757521047374676e+224+4.010186513222081e+152i)
-	// (6.556580829938433e+219+9.452945816540518e+154i)
-	// (4.151349376347527e-207+4.786664746486726e+126i)
-	// (-2.872024231170062e+70+1.8272776570354314e-65i)
+	// (-1.194033741351553e-241-3.93536176617243e-117i)
+	// (-2.978088836427668e+188-64716.894033756i)
+	// (-1.6925393061358905e+61-3.290689787053985e-12i)
+	// (1.8281685070362825e+43-4.579610072238924e+81i)
+	// (2.5420973754048248e+146-2.9721426814134555e-286i)
+	// (-4.07647343069254e-182-2.497850090921009e+236i)
+	// (2.78249623188919e+86+4.120048535147697e+56i)
+	// (2.33038011922896e-289+9.142337453567358e-167i)
+	// (1.374619546296366e+49+1.5979905149546695e-148i)
+	// (-7.908173283514067e+37-5.433575384625165e+75i)
 }
 
 // This example demonstrates usage of Complex128() generator with constraints for generation of complex128 values.
@@ -45,13 +45,13 @@ func ExampleConstantFrom() {
 	}
 	// Output:
 	// blue
-	// red
-	// red
-	// green
-	// black
 	// yellow
 	// red
-	// yellow
+	// black
+	// green
 	// yellow
 	// green
+	// black
+	// yellow
+	// blue
 }
@@ -0,0 +1,99 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// ContextKey is the type of keys under which [Context] generator stores values in the
+// generated context. Values are stored under keys ContextKey(0), ContextKey(1), etc.
+type ContextKey int
+
+// contextParts is an intermediate representation of context.Context value. Deadline and
+// Cancel are delays from context's creation, and they are nil if context has no deadline
+// or is never cancelled respectively.
+type contextParts struct {
+	Deadline *time.Duration
+	Cancel   *time.Duration
+	Values   []uint64
+}
+
+// Context returns generator for context.Context type. Generated context optionally has a
+// deadline, optionally is cancelled after a delay, and has random uint64 values stored
+// under [ContextKey] keys. Ranges of deadlines and delays, number of values and frequency
+// of contexts without deadline or cancellation are defined by "limits" parameter. The
+// "limits" parameter, even though it is variadic, evaluates only the first instance of
+// [constraints.Context]. If limits are omitted, [constraints.ContextDefault] is used
+// instead. Deadline and cancellation delay are relative to the moment the context is
+// generated, not to the moment property receives it, thus time that passes between the
+// two counts towards them. Context that has a deadline or cancellation delay is cancelled
+// once the next shrink candidate is generated. Each shrink candidate gets a new context,
+// thus property that depends on the time remaining until the deadline or cancellation
+// can pass and fail for the same candidate while it's being shrunk. Generated values are
+// shrunk towards contexts that are never cancelled and have no deadline and no values.
+// Error is returned if generator's target is not an interface implemented by
+// context.Context, or limits are invalid.
+func Context(limits ...constraints.Context) arbitrary.Generator {
+	constraint := constraints.ContextDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		switch {
+		case target.Kind() != reflect.Interface || !reflect.TypeOf((*context.Context)(nil)).Elem().Implements(target):
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Context")
+		case constraint.Deadline.Min < 0 || constraint.Deadline.Min > constraint.Deadline.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Deadline range [%s, %s] is invalid", arbitrary.ErrorInvalidConstraints, constraint.Deadline.Min, constraint.Deadline.Max)
+		case constraint.Cancel.Min < 0 || constraint.Cancel.Min > constraint.Cancel.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Cancel range [%s, %s] is invalid", arbitrary.ErrorInvalidConstraints, constraint.Cancel.Min, constraint.Cancel.Max)
+		case constraint.Values.Min > constraint.Values.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal number of values %d can't be greater than max number of values %d", arbitrary.ErrorInvalidConstraints, constraint.Values.Min, constraint.Values.Max)
+		}
+
+		// Each shrink candidate cancels the context of the previous one, which releases it's
+		// timers, as the property is done with it by the time the next candidate is created.
+		release := func() {}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(contextParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(contextParts)
+			release()
+
+			ctx, cancels := context.Background(), []func(){}
+			for index, value := range parts.Values {
+				ctx = context.WithValue(ctx, ContextKey(index), value)
+			}
+			if parts.Deadline != nil {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, *parts.Deadline)
+				cancels = append(cancels, cancel)
+			}
+			if parts.Cancel != nil {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithCancel(ctx)
+				timer := time.AfterFunc(*parts.Cancel, cancel)
+				cancels = append(cancels, func() { timer.Stop() }, cancel)
+			}
+			release = func() {
+				for _, cancel := range cancels {
+					cancel()
+				}
+			}
+
+			value := reflect.New(target).Elem()
+			value.Set(reflect.ValueOf(ctx))
+			return value
+		})
+
+		return Struct(map[string]arbitrary.Generator{
+			"Deadline": Ptr(Duration(constraint.Deadline), constraint.Optional),
+			"Cancel":   Ptr(Duration(constraint.Cancel), constraint.Optional),
+			"Values":   Slice(Uint64(), constraint.Values),
+		}).Map(mapper)(target, bias, r)
+	}
+}
@@ -0,0 +1,31 @@
+package generator_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Context() generator for generation of context.Context
+// values. Generated contexts optionally have a deadline, optionally are cancelled after a
+// delay, and carry values stored under generator.ContextKey keys.
+func ExampleContext() {
+	streamer := generator.Streamer(
+		func(ctx context.Context) {
+			_, deadline := ctx.Deadline()
+			fmt.Printf("deadline: %t, cancellable: %t, value: %v\n", deadline, ctx.Done() != nil, ctx.Value(generator.ContextKey(0)))
+		},
+		generator.Context(),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// deadline: false, cancellable: true, value: 7861855757602232086
+	// deadline: true, cancellable: true, value: 2119085704421221023
+	// deadline: false, cancellable: false, value: 5342060400326742095
+	// deadline: true, cancellable: true, value: 5437755695196026163
+	// deadline: false, cancellable: false, value: 2727171422159354966
+}
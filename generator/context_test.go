@@ -0,0 +1,168 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestContext(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			streamers := []streamer{
+				Streamer(func(int) {}, Context()),
+				Streamer(func(error) {}, Context()),
+			}
+
+			for _, streamer := range streamers {
+				if err := Stream(0, 10, streamer); !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+					t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidTarget, err)
+				}
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			for _, limits := range []constraints.Context{
+				{Deadline: constraints.Duration{Min: -1, Max: 0}},
+				{Deadline: constraints.Duration{Min: 2, Max: 1}},
+				{Cancel: constraints.Duration{Min: -1, Max: 0}},
+				{Cancel: constraints.Duration{Min: 2, Max: 1}},
+				{Values: constraints.Length{Min: 2, Max: 1}},
+			} {
+				err := Stream(0, 10, Streamer(func(context.Context) {}, Context(limits)))
+				if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+					t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidConstraints, err)
+				}
+			}
+		},
+		"Interface": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(func(interface{ Done() <-chan struct{} }) {}, Context()))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"DeadlineWithinLimits": func(t *testing.T) {
+			limits := constraints.ContextDefault()
+			limits.Deadline = constraints.Duration{Min: time.Second, Max: time.Minute}
+			limits.Optional = constraints.Ptr{NilFrequency: 0}
+
+			err := Stream(0, 100, Streamer(
+				func(ctx context.Context) {
+					deadline, ok := ctx.Deadline()
+					if !ok {
+						t.Fatalf("Expected context to have a deadline")
+					}
+					if until := time.Until(deadline); until > time.Minute {
+						t.Fatalf("Deadline %s is out of range [1s, 1m]", until)
+					}
+				},
+				Context(limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Cancelled": func(t *testing.T) {
+			limits := constraints.ContextDefault()
+			limits.Optional = constraints.Ptr{NilFrequency: 0}
+
+			err := Stream(0, 100, Streamer(
+				func(ctx context.Context) {
+					<-ctx.Done()
+					if err := ctx.Err(); !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+						t.Fatalf("Unexpected context error: %v", err)
+					}
+				},
+				Context(limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Values": func(t *testing.T) {
+			limits := constraints.ContextDefault()
+			limits.Values = constraints.Length{Min: 3, Max: 3}
+
+			err := Stream(0, 100, Streamer(
+				func(ctx context.Context) {
+					for key := ContextKey(0); key < 3; key++ {
+						if _, ok := ctx.Value(key).(uint64); !ok {
+							t.Fatalf("Expected uint64 value for key %d. Got: %v", key, ctx.Value(key))
+						}
+					}
+					if value := ctx.Value(ContextKey(3)); value != nil {
+						t.Fatalf("Unexpected value for key 3: %v", value)
+					}
+				},
+				Context(limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"ShrinkToNeverCancelled": func(t *testing.T) {
+			limits := constraints.ContextDefault()
+			limits.Values = constraints.Length{Min: 1, Max: 5}
+
+			shrunk := shrink(t, Context(limits), reflect.TypeOf((*context.Context)(nil)).Elem(), func(v reflect.Value) bool {
+				return v.Interface().(context.Context).Value(ContextKey(0)) != nil
+			})
+
+			ctx := shrunk.Interface().(context.Context)
+			if _, ok := ctx.Deadline(); ok || ctx.Done() != nil {
+				t.Fatalf("Expected context without deadline that is never cancelled")
+			}
+			if value := ctx.Value(ContextKey(0)); value != uint64(0) || ctx.Value(ContextKey(1)) != nil {
+				t.Fatalf("Expected context with a single 0 value")
+			}
+		},
+		"ShrinkWithFlakyProperty": func(t *testing.T) {
+			// Property that depends on the remaining time can pass or fail regardless of
+			// the shrink candidate, which must not break the shrinking.
+			for seed := int64(0); seed < 100; seed++ {
+				flaky := rand.New(rand.NewSource(seed))
+				arb, err := Context()(reflect.TypeOf((*context.Context)(nil)).Elem(), constraints.Bias{Size: 1, Scaling: 1}, arbitrary.NewRandomNumber(seed))
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				for arb.Shrinker != nil {
+					if arb, err = arb.Shrinker(arb, flaky.Intn(2) == 0); err != nil {
+						t.Fatalf("Unexpected shrinking error for seed %d: %s", seed, err)
+					}
+				}
+			}
+		},
+		"ShrinkCancelsPreviousCandidates": func(t *testing.T) {
+			limits := constraints.ContextDefault()
+			limits.Deadline = constraints.Duration{Min: time.Hour, Max: time.Hour}
+			limits.Optional = constraints.Ptr{NilFrequency: 0}
+
+			contexts := []context.Context{}
+			shrink(t, Context(limits), reflect.TypeOf((*context.Context)(nil)).Elem(), func(v reflect.Value) bool {
+				contexts = append(contexts, v.Interface().(context.Context))
+				return true
+			})
+
+			for _, ctx := range contexts[:len(contexts)-1] {
+				if _, ok := ctx.Deadline(); ok && !errors.Is(ctx.Err(), context.Canceled) {
+					t.Fatalf("Expected context of previous shrink candidate to be cancelled. Got: %v", ctx.Err())
+				}
+			}
+			if err := contexts[len(contexts)-1].Err(); err != nil {
+				t.Fatalf("Expected context of last shrink candidate not to be cancelled. Got: %s", err)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
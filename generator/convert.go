@@ -0,0 +1,43 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// convert returns generator that uses "generator" to generate value of "source" type, and
+// converts it (and all of it's shrinks) to generator's target with "convertFn". Unlike Map
+// combinator, convertFn is called directly instead of being called through reflection, which
+// is used for generators of primitive kinds.
+func convert(generator arbitrary.Generator, source reflect.Type, convertFn func(reflect.Value) reflect.Value) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		arb, err := generator(source, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, fmt.Errorf("Failed to use base generator. %w", err)
+		}
+		return converted(arb, convertFn), nil
+	}
+}
+
+// converted returns arbitrary whose value is arb's value converted with "convertFn", and
+// that's shrunk by converting arb's shrinks.
+func converted(arb arbitrary.Arbitrary, convertFn func(reflect.Value) reflect.Value) arbitrary.Arbitrary {
+	node := arbitrary.Arbitrary{
+		Value:      convertFn(arb.Value),
+		Precursors: arbitrary.Arbitraries{arb},
+	}
+
+	if shrinker := arb.Shrinker; shrinker != nil {
+		node.Shrinker = func(node arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+			shrink, err := shrinker(node.Precursors[0], propertyFailed)
+			if err != nil {
+				return arbitrary.Arbitrary{}, err
+			}
+			return converted(shrink, convertFn), nil
+		}
+	}
+	return node
+}
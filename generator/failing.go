@@ -0,0 +1,274 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// failureParts is an intermediate representation of a failing value. Value is the wrapped
+// value, Rate is the percentage of calls that fail, and Errors is the number of errors
+// (starting with the first one) that can be injected.
+type failureParts struct {
+	Value  interface{}
+	Rate   uint64
+	Errors uint64
+}
+
+// failure decides which calls of a failing value fail.
+type failure struct {
+	seed   int64
+	rate   uint64
+	errors []error
+}
+
+// inject returns error injected into the call whose inputs are specified by "inputs"
+// parameter, or nil if call doesn't fail. Decision is made by random number generator
+// seeded with the hash of the inputs, thus the same inputs always have the same outcome.
+// Random number generator is returned as well, to be used for other decisions about the
+// call. Calls that fail for a rate, fail for any higher rate too.
+func (f failure) inject(inputs ...reflect.Value) (error, arbitrary.Random) {
+	r := arbitrary.NewRandomPCG(arbitrary.HashToInt64(inputs...) + f.seed)
+	if r.Uint64(constraints.Uint64{Min: 0, Max: 99}) >= f.rate {
+		return nil, r
+	}
+	return f.errors[r.Uint64(constraints.Uint64{Min: 0, Max: uint64(len(f.errors) - 1)})], r
+}
+
+// failingReader is io.Reader created by [FailingReader] generator.
+type failingReader struct {
+	reader  io.Reader
+	failure failure
+	calls   int
+}
+
+// Read is implementation of io.Reader.Read
+func (r *failingReader) Read(p []byte) (int, error) {
+	r.calls++
+	if err, _ := r.failure.inject(reflect.ValueOf(r.calls), reflect.ValueOf(len(p))); err != nil {
+		return 0, err
+	}
+	return r.reader.Read(p)
+}
+
+// failingWriter is io.Writer created by [FailingWriter] generator.
+type failingWriter struct {
+	writer  io.Writer
+	failure failure
+	calls   int
+}
+
+// Write is implementation of io.Writer.Write
+func (w *failingWriter) Write(p []byte) (int, error) {
+	w.calls++
+	err, r := w.failure.inject(reflect.ValueOf(w.calls), reflect.ValueOf(p))
+	if err == nil || len(p) == 0 {
+		return w.writer.Write(p)
+	}
+
+	n, writeErr := w.writer.Write(p[:r.Uint64(constraints.Uint64{Min: 0, Max: uint64(len(p) - 1)})])
+	if writeErr != nil {
+		return n, writeErr
+	}
+	return n, err
+}
+
+// FailingReader returns generator for io.Reader values that wrap readers generated by "reader"
+// parameter, and inject errors into their reads. Each read fails with one of limits.Errors
+// with probability of up to limits.Rate percent, without reading from the wrapped reader.
+// Outcome of a read is decided by the hash of read's index and buffer size, so that the same
+// sequence of reads always has the same outcome. The "limits" parameter, even though it is
+// variadic, evaluates only the first instance of [constraints.Failure]. If limits are omitted,
+// [constraints.FailureDefault] is used instead. Readers are shrunk towards fewer injected
+// failures and towards injecting the first error, while wrapped reader is shrunk by it's own
+// shrinker. Error is returned if generator's target is not an interface implemented by the
+// reader (for example io.Reader), limits are invalid, reader generator returns an error or
+// generates a nil reader.
+func FailingReader(reader arbitrary.Generator, limits ...constraints.Failure) arbitrary.Generator {
+	return failing("FailingReader", reflect.TypeOf((*io.Reader)(nil)).Elem(), reader, limits, func(value reflect.Value, f failure) reflect.Value {
+		reader, _ := value.Interface().(io.Reader)
+		return reflect.ValueOf(&failingReader{
+			reader:  reader,
+			failure: f,
+		})
+	})
+}
+
+// FailingWriter returns generator for io.Writer values that wrap writers generated by "writer"
+// parameter, and inject errors into their writes. Each write fails with one of limits.Errors
+// with probability of up to limits.Rate percent. Failing write is a partial write: random
+// number of bytes, lower than the length of the buffer, is written to the wrapped writer
+// before the error is returned. Outcome of a write is decided by the hash of write's index
+// and the buffer, so that the same sequence of writes always has the same outcome. The
+// "limits" parameter, even though it is variadic, evaluates only the first instance of
+// [constraints.Failure]. If limits are omitted, [constraints.FailureDefault] is used
+// instead. Writers are shrunk towards fewer injected failures and towards injecting the
+// first error, while wrapped writer is shrunk by it's own shrinker. Error is returned if
+// generator's target is not an interface implemented by the writer (for example io.Writer),
+// limits are invalid, writer generator returns an error or generates a nil writer.
+func FailingWriter(writer arbitrary.Generator, limits ...constraints.Failure) arbitrary.Generator {
+	return failing("FailingWriter", reflect.TypeOf((*io.Writer)(nil)).Elem(), writer, limits, func(value reflect.Value, f failure) reflect.Value {
+		writer, _ := value.Interface().(io.Writer)
+		return reflect.ValueOf(&failingWriter{
+			writer:  writer,
+			failure: f,
+		})
+	})
+}
+
+// FailingFunc returns generator for functions whose last output value is an error, that wrap
+// functions generated by "function" parameter and inject errors into their calls. Each call
+// fails with one of limits.Errors with probability of up to limits.Rate percent, returning
+// zero values for other outputs, without calling the wrapped function. Outcome of a call is
+// decided by the hash of call's inputs, similar to [Func] generator, so that calls with the
+// same inputs always have the same outcome. The "limits" parameter, even though it is
+// variadic, evaluates only the first instance of [constraints.Failure]. If limits are
+// omitted, [constraints.FailureDefault] is used instead. Functions are shrunk towards fewer
+// injected failures and towards injecting the first error, while wrapped function is shrunk
+// by it's own shrinker. Like functions generated by [Func] generator, function records the
+// calls it's made with, and it's encoded (see [arbitrary.EncodeArbitraryToString]) as a table
+// of recorded calls, including the ones that failed. Error is returned if generator's target is not a function whose last
+// output is an error, limits are invalid, function generator returns an error or generates
+// a nil function.
+func FailingFunc(function arbitrary.Generator, limits ...constraints.Failure) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		errorType := reflect.TypeOf((*error)(nil)).Elem()
+		if target.Kind() != reflect.Func || target.NumOut() == 0 || target.Out(target.NumOut()-1) != errorType {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "FailingFunc")
+		}
+
+		// calls records calls of the last function created by the wrapper, so that they can
+		// be used as a table of the arbitrary that holds it.
+		var calls *funcCalls
+		tabled := func(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+			arb.Table = calls.table
+			return arb
+		}
+
+		arb, err := failing("FailingFunc", target, function, limits, func(value reflect.Value, f failure) reflect.Value {
+			calls = &funcCalls{keys: map[string]struct{}{}}
+			record := calls.record
+
+			return reflect.MakeFunc(target, func(inputs []reflect.Value) []reflect.Value {
+				outputs := make([]reflect.Value, target.NumOut())
+				defer func() {
+					arbitraries := make(arbitrary.Arbitraries, len(outputs))
+					for index, output := range outputs {
+						arbitraries[index] = arbitrary.Arbitrary{Value: output}
+					}
+					record(inputs, arbitraries)
+				}()
+
+				err, _ := f.inject(inputs...)
+				if err == nil {
+					outputs = value.Call(inputs)
+					return outputs
+				}
+
+				for index := range outputs {
+					outputs[index] = reflect.Zero(target.Out(index))
+				}
+				outputs[len(outputs)-1] = reflect.ValueOf(&err).Elem()
+				return outputs
+			})
+		})(target, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, err
+		}
+		arb.Shrinker = arb.Shrinker.TransformAfter(tabled)
+		return tabled(arb), nil
+	}
+}
+
+// failing returns generator of values that wrap values of "wrapped" type, generated by
+// "generator" parameter. Wrapper is created by "wrap" parameter and generated value is
+// converted to generator's target.
+func failing(name string, wrapped reflect.Type, generator arbitrary.Generator, limits []constraints.Failure, wrap func(reflect.Value, failure) reflect.Value) arbitrary.Generator {
+	constraint := constraints.FailureDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		switch {
+		case target.Kind() == reflect.Interface && !wrap(reflect.Zero(wrapped), failure{}).Type().Implements(target):
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, name)
+		case target.Kind() != reflect.Interface && target != wrapped:
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, name)
+		case constraint.Rate > 100:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Failure rate %d can't be greater than 100", arbitrary.ErrorInvalidConstraints, constraint.Rate)
+		case len(constraint.Errors) == 0:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. At least one error must be specified", arbitrary.ErrorInvalidConstraints)
+		}
+
+		seed := int64(r.Uint64(constraints.Uint64Default()))
+		mapper := arbitrary.Mapper(reflect.TypeOf(failureParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(failureParts)
+			value := reflect.ValueOf(parts.Value)
+			if !value.IsValid() {
+				value = reflect.Zero(wrapped)
+			}
+
+			return wrap(value, failure{
+				seed:   seed,
+				rate:   parts.Rate,
+				errors: constraint.Errors[:parts.Errors+1],
+			}).Convert(target)
+		})
+
+		return Struct(map[string]arbitrary.Generator{
+			"Value":  wrappedValue(wrapped, notNil(name, generator)),
+			"Rate":   Uint64(constraints.Uint64{Min: 0, Max: constraint.Rate}),
+			"Errors": Uint64(constraints.Uint64{Min: 0, Max: uint64(len(constraint.Errors) - 1)}),
+		}).Map(mapper)(target, bias, r)
+	}
+}
+
+// notNil returns generator that generates values with "generator" parameter, and returns an
+// error if generated value is nil, as wrapper would panic when calling it. Shrinks of the
+// generated value that are nil are skipped.
+func notNil(name string, generator arbitrary.Generator) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		arb, err := generator(target, bias, r)
+		switch {
+		case err != nil:
+			return arbitrary.Arbitrary{}, err
+		case isNil(arb.Value):
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. %s can't wrap nil %s", arbitrary.ErrorInvalidConfig, name, target)
+		}
+
+		arb.Shrinker = arb.Shrinker.Filter(arbitrary.FilterPredicate(target, func(value reflect.Value) bool {
+			return !isNil(value)
+		}))
+		return arb, nil
+	}
+}
+
+// isNil returns true if value is invalid, nil pointer, nil function or an interface that is
+// nil or holds a nil value.
+func isNil(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Interface:
+		return value.IsNil() || isNil(value.Elem())
+	case reflect.Pointer, reflect.Func:
+		return value.IsNil()
+	default:
+		return false
+	}
+}
+
+// wrappedValue returns generator for interface{} values, that generates values of "wrapped"
+// type with "generator" parameter.
+func wrappedValue(wrapped reflect.Type, generator arbitrary.Generator) arbitrary.Generator {
+	mapper := arbitrary.Mapper(wrapped, reflect.TypeOf((*interface{})(nil)).Elem(), func(in reflect.Value) reflect.Value {
+		out := reflect.New(reflect.TypeOf((*interface{})(nil)).Elem()).Elem()
+		out.Set(in)
+		return out
+	})
+	return generator.Map(mapper)
+}
@@ -0,0 +1,106 @@
+package generator_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use FailingWriter() generator for generation of io.Writer
+// values that randomly fail with partial writes. Writes are retried until all the data is
+// written.
+func ExampleFailingWriter() {
+	var builder strings.Builder
+	streamer := generator.Streamer(
+		func(w io.Writer) {
+			builder.Reset()
+			data := []byte("retry")
+			for attempt := 1; len(data) > 0; attempt++ {
+				n, err := w.Write(data)
+				fmt.Printf("attempt %d: %q %v\n", attempt, data[:n], err)
+				data = data[n:]
+			}
+			fmt.Printf("written: %q\n\n", builder.String())
+		},
+		generator.FailingWriter(generator.Constant(&builder), constraints.Failure{
+			Rate:   50,
+			Errors: []error{io.ErrShortWrite},
+		}),
+	)
+
+	if err := generator.Stream(0, 4, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// attempt 1: "retry" <nil>
+	// written: "retry"
+	//
+	// attempt 1: "ret" short write
+	// attempt 2: "r" short write
+	// attempt 3: "y" <nil>
+	// written: "retry"
+	//
+	// attempt 1: "retr" short write
+	// attempt 2: "y" <nil>
+	// written: "retry"
+	//
+	// attempt 1: "r" short write
+	// attempt 2: "etry" <nil>
+	// written: "retry"
+}
+
+// This example demonstrates how to use FailingFunc() generator for generation of functions
+// that randomly fail with one of the specified errors. Calls with the same inputs always
+// have the same outcome.
+func ExampleFailingFunc() {
+	errNotFound, errUnavailable := errors.New("not found"), errors.New("unavailable")
+	streamer := generator.Streamer(
+		func(lookup func(string) (int, error)) {
+			for _, key := range []string{"a", "b", "c", "a"} {
+				value, err := lookup(key)
+				fmt.Printf("%s: %d %v\n", key, value, err)
+			}
+			fmt.Println()
+		},
+		generator.FailingFunc(
+			generator.Func(generator.Int(constraints.Int{Min: 0, Max: 100}), generator.Nil()),
+			constraints.Failure{
+				Rate:   50,
+				Errors: []error{errNotFound, errUnavailable},
+			},
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// a: 45 <nil>
+	// b: 76 <nil>
+	// c: 11 <nil>
+	// a: 45 <nil>
+	//
+	// a: 95 <nil>
+	// b: 70 <nil>
+	// c: 50 <nil>
+	// a: 95 <nil>
+	//
+	// a: 9 <nil>
+	// b: 7 <nil>
+	// c: 99 <nil>
+	// a: 9 <nil>
+	//
+	// a: 74 <nil>
+	// b: 83 <nil>
+	// c: 80 <nil>
+	// a: 74 <nil>
+	//
+	// a: 0 not found
+	// b: 0 not found
+	// c: 47 <nil>
+	// a: 0 not found
+}
@@ -0,0 +1,182 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestFailing(t *testing.T) {
+	errFirst, errSecond := errors.New("first"), errors.New("second")
+	limits := constraints.Failure{Rate: 50, Errors: []error{errFirst, errSecond}}
+	data := constraints.ReaderDefault()
+	data.Err = nil
+
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			streamers := []streamer{
+				Streamer(func([]byte) {}, FailingReader(Reader(data))),
+				Streamer(func(io.ReadCloser) {}, FailingReader(Reader(data))),
+				Streamer(func(io.Reader) {}, FailingWriter(Constant(io.Discard))),
+				Streamer(func(func(int) int) {}, FailingFunc(Func(Int()))),
+				Streamer(func(int) {}, FailingFunc(Func(Int()))),
+			}
+
+			for _, streamer := range streamers {
+				if err := Stream(0, 10, streamer); !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+					t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidTarget, err)
+				}
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			for _, limits := range []constraints.Failure{
+				{Rate: 101, Errors: []error{errFirst}},
+				{Rate: 50},
+			} {
+				err := Stream(0, 10, Streamer(func(io.Reader) {}, FailingReader(Reader(data), limits)))
+
+				if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+					t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidConstraints, err)
+				}
+			}
+		},
+		"NilWrapped": func(t *testing.T) {
+			streamers := []streamer{
+				Streamer(func(io.Reader) {}, FailingReader(Nil())),
+				Streamer(func(io.Reader) {}, FailingReader(Constant((*bytes.Buffer)(nil)))),
+				Streamer(func(io.Writer) {}, FailingWriter(Nil())),
+				Streamer(func(func(int) error) {}, FailingFunc(Nil())),
+			}
+
+			for _, streamer := range streamers {
+				if err := Stream(0, 10, streamer); !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+					t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidConfig, err)
+				}
+			}
+		},
+		"Deterministic": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(f func(int) (int, error)) {
+					for input := 0; input < 10; input++ {
+						out1, err1 := f(input)
+						out2, err2 := f(input)
+						if out1 != out2 || err1 != err2 {
+							t.Fatalf("Expected the same outcome for input %d. Got: (%d, %v) and (%d, %v)", input, out1, err1, out2, err2)
+						}
+					}
+				},
+				FailingFunc(Func(Int(), Nil()), limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Failures": func(t *testing.T) {
+			failures := map[error]int{}
+			err := Stream(0, 100, Streamer(
+				func(r io.Reader) {
+					_, err := io.ReadAll(r)
+					if err != nil && err != errFirst && err != errSecond {
+						t.Fatalf("Unexpected error: %s", err)
+					}
+					failures[err]++
+				},
+				FailingReader(Reader(data), limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if failures[nil] == 0 || failures[errFirst] == 0 || failures[errSecond] == 0 {
+				t.Fatalf("Expected readers to fail with both errors and to succeed. Got: %v", failures)
+			}
+		},
+		"NeverFails": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(r io.Reader) {
+					if _, err := io.ReadAll(r); err != nil {
+						t.Fatalf("Unexpected error: %s", err)
+					}
+				},
+				FailingReader(Reader(data), constraints.Failure{Rate: 0, Errors: []error{errFirst}}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"PartialWrites": func(t *testing.T) {
+			failures := 0
+			err := Stream(0, 100, Streamer(
+				func(w io.Writer) {
+					for _, p := range [][]byte{[]byte("a"), []byte("abc"), []byte("abcdef")} {
+						n, err := w.Write(p)
+						switch {
+						case err == nil && n != len(p):
+							t.Fatalf("Expected full write of %d bytes. Got: %d", len(p), n)
+						case err != nil && n >= len(p):
+							t.Fatalf("Expected partial write of less than %d bytes. Got: %d", len(p), n)
+						case err != nil:
+							failures++
+						}
+					}
+				},
+				FailingWriter(Constant(io.Discard), limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if failures == 0 {
+				t.Fatalf("Expected some of the writes to fail")
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			failed := func(f func(int) (int, error)) []error {
+				errs := []error{}
+				for input := 0; input < 20; input++ {
+					if _, err := f(input); err != nil {
+						errs = append(errs, err)
+					}
+				}
+				return errs
+			}
+
+			shrunk := shrink(t, FailingFunc(Func(Int(), Nil()), limits), reflect.TypeOf(func(int) (int, error) { return 0, nil }), func(v reflect.Value) bool {
+				return len(failed(v.Interface().(func(int) (int, error)))) > 0
+			})
+
+			errs := failed(shrunk.Interface().(func(int) (int, error)))
+			if len(errs) != 1 || errs[0] != errFirst {
+				t.Fatalf("Expected function to be shrunk to a single failure with first error. Got: %v", errs)
+			}
+		},
+		"EncodeTable": func(t *testing.T) {
+			// Function is encoded with the calls it's made with, including the failed ones.
+			shrunk := shrinkArbitrary(t, FailingFunc(Func(Int(), Nil()), limits), reflect.TypeOf(func(int) (int, error) { return 0, nil }), func(v reflect.Value) bool {
+				f := v.Interface().(func(int) (int, error))
+				for input := 0; input < 10; input++ {
+					if _, err := f(input); err != nil {
+						return true
+					}
+				}
+				return false
+			})
+
+			expected := "<func(int) (int, error)> func{0 -> (0, <nil>), 1 -> (0, <nil>), 2 -> (0, first)}"
+			if encoded := arbitrary.EncodeArbitraryToString(shrunk); encoded != expected {
+				t.Fatalf("Expected function to be encoded as: %s. Got: %s", expected, encoded)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -21,9 +21,11 @@ func Float64(limits ...constraints.Float64) arbitrary.Generator {
 	}
 
 	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
-		mapper := arbitrary.Mapper(reflect.TypeOf(uint64(0)), target, func(in reflect.Value) reflect.Value {
+		fromBits := func(in reflect.Value) reflect.Value {
 			return reflect.ValueOf(math.Float64frombits(in.Uint())).Convert(target)
-		})
+		}
+
+		uint64Type := reflect.TypeOf(uint64(0))
 
 		switch {
 		case target.Kind() != reflect.Float64:
@@ -35,30 +37,30 @@ func Float64(limits ...constraints.Float64) arbitrary.Generator {
 		case constraint.Max < constraint.Min:
 			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Lower range value can't be greater then upper range value", arbitrary.ErrorInvalidConstraints)
 		case constraint.Min >= math.Copysign(0, 1):
-			return Uint64(
+			return convert(Uint64(
 				constraints.Uint64{
 					Min: math.Float64bits(constraint.Min),
 					Max: math.Float64bits(constraint.Max),
-				}).Map(mapper)(target, bias, r)
+				}), uint64Type, fromBits)(target, bias, r)
 		case constraint.Max <= math.Copysign(0, -1):
-			return Uint64(constraints.Uint64{
+			return convert(Uint64(constraints.Uint64{
 				Min: math.Float64bits(math.Copysign(constraint.Max, -1)),
 				Max: math.Float64bits(constraint.Min),
-			}).Map(mapper)(target, bias, r)
+			}), uint64Type, fromBits)(target, bias, r)
 		default:
-			return Weighted(
+			return branch(
 				[]uint64{
 					uint64(math.Float64bits(math.Copysign(constraint.Min, 1))) + 1,
 					uint64(math.Float64bits(constraint.Max)) + 1,
 				},
-				Uint64(constraints.Uint64{
+				convert(Uint64(constraints.Uint64{
 					Min: math.Float64bits(math.Copysign(0, -1)),
 					Max: math.Float64bits(constraint.Min),
-				}).Map(mapper),
-				Uint64(constraints.Uint64{
+				}), uint64Type, fromBits),
+				convert(Uint64(constraints.Uint64{
 					Min: 0,
 					Max: math.Float64bits(constraint.Max),
-				}).Map(mapper),
+				}), uint64Type, fromBits),
 			)(target, bias, r)
 		}
 	}
@@ -76,9 +78,11 @@ func Float32(limits ...constraints.Float32) arbitrary.Generator {
 	}
 
 	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
-		mapper := arbitrary.Mapper(reflect.TypeOf(uint32(0)), target, func(in reflect.Value) reflect.Value {
+		fromBits := func(in reflect.Value) reflect.Value {
 			return reflect.ValueOf(math.Float32frombits(uint32(in.Uint()))).Convert(target)
-		})
+		}
+
+		uint32Type := reflect.TypeOf(uint32(0))
 
 		switch {
 		case target.Kind() != reflect.Float32:
@@ -90,29 +94,29 @@ func Float32(limits ...constraints.Float32) arbitrary.Generator {
 		case constraint.Max < constraint.Min:
 			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Lower range value can't be greater then upper range value", arbitrary.ErrorInvalidConstraints)
 		case constraint.Min >= 0:
-			return Uint32(constraints.Uint32{
+			return convert(Uint32(constraints.Uint32{
 				Min: math.Float32bits(constraint.Min),
 				Max: math.Float32bits(constraint.Max),
-			}).Map(mapper)(target, bias, r)
+			}), uint32Type, fromBits)(target, bias, r)
 		case constraint.Max <= 0:
-			return Uint32(constraints.Uint32{
+			return convert(Uint32(constraints.Uint32{
 				Min: math.Float32bits(float32(math.Copysign(float64(constraint.Max), -1))),
 				Max: math.Float32bits(constraint.Min),
-			}).Map(mapper)(target, bias, r)
+			}), uint32Type, fromBits)(target, bias, r)
 		default:
-			return Weighted(
+			return branch(
 				[]uint64{
 					uint64(math.Float32bits(-constraint.Min)) + 1,
 					uint64(math.Float32bits(constraint.Max)) + 1,
 				},
-				Uint32(constraints.Uint32{
+				convert(Uint32(constraints.Uint32{
 					Min: math.Float32bits(float32(math.Copysign(0, -1))),
 					Max: math.Float32bits(constraint.Min),
-				}).Map(mapper),
-				Uint32(constraints.Uint32{
+				}), uint32Type, fromBits),
+				convert(Uint32(constraints.Uint32{
 					Min: 0,
 					Max: math.Float32bits(constraint.Max),
-				}).Map(mapper),
+				}), uint32Type, fromBits),
 			)(target, bias, r)
 		}
 	}
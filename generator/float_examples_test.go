@@ -20,16 +20,16 @@ func ExampleFloat32() {
 		panic(err)
 	}
 	// Output:
-	// -2.4597852e+30
+	// 6.5711266e-15
 	// -2.84718e-09
+	// 1.841146e+06
 	// 3.9155332e-22
-	// 5.336489e+16
-	// 5.3546978e+23
-	// -6.6470676e-23
+	// 1.0390683e+06
+	// -2.3398438e-37
+	// -5.3546978e+23
+	// 6.6470676e-23
+	// -1.5922673e+10
 	// 2.0516037e-32
-	// 6.878372e+15
-	// -2.5820768e+34
-	// -7.02896e-34
 }
 
 // This example demonstrates usage of Float32() generator with constraints for generation of float32 values.
@@ -74,16 +74,16 @@ func ExampleFloat64() {
 		panic(err)
 	}
 	// Output:
-	// -1.3131993411626801e-06
-	// 2.6499394281062435e-102
-	// -5.544832151478103e+28
+	// -1.194033741351553e-241
+	// -3.93536176617243e-117
+	// -2.978088836427668e+188
+	// -64716.894033756
 	// -1.6925393061358905e+61
-	// -1.3148118989728537e-70
+	// -3.290689787053985e-12
+	// 1.8281685070362825e+43
 	// -4.579610072238924e+81
-	// -3.1950849453106405e+135
-	// 1.6727313548317884e-205
-	// 2.78249623188919e+86
-	// 5.04589615041297e+124
+	// 2.5420973754048248e+146
+	// -2.9721426814134555e-286
 }
 
 // This example demonstrates usage of Float64() generator with constraints for generation of float64 values.
@@ -3,16 +3,76 @@ package generator
 import (
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/steffnova/go-check/arbitrary"
 	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
 )
 
+// funcCall is a recorded call of generated function.
+type funcCall struct {
+	inputs  []reflect.Value
+	outputs arbitrary.Arbitraries
+}
+
+// funcCalls records distinct calls of generated function in the order they were made.
+type funcCalls struct {
+	lock  sync.Mutex
+	keys  map[string]struct{}
+	calls []funcCall
+}
+
+// record records the call with "inputs" if a call with the same inputs wasn't already recorded.
+func (f *funcCalls) record(inputs []reflect.Value, outputs arbitrary.Arbitraries) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	key := ""
+	for _, input := range inputs {
+		key += arbitrary.EncodeToString(input) + ", "
+	}
+	if _, exists := f.keys[key]; exists {
+		return
+	}
+	f.keys[key] = struct{}{}
+	f.calls = append(f.calls, funcCall{
+		inputs:  append([]reflect.Value(nil), inputs...),
+		outputs: outputs,
+	})
+}
+
+// recorded returns a copy of recorded calls.
+func (f *funcCalls) recorded() []funcCall {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return append([]funcCall(nil), f.calls...)
+}
+
+// table returns recorded calls as a function table.
+func (f *funcCalls) table() arbitrary.FuncTable {
+	calls := f.recorded()
+	table := arbitrary.FuncTable{Entries: make([]arbitrary.FuncEntry, len(calls))}
+	for index, call := range calls {
+		table.Entries[index] = arbitrary.FuncEntry{
+			Inputs:  call.inputs,
+			Outputs: call.outputs.Values(),
+		}
+	}
+	return table
+}
+
 // Func returns generator for pure functions. arbitrary.Arbitraryd function is defined by
 // it's output values, and generator for each output value needs to be provided
-// through "outputs" parameter. Error is returned if generator's target is not a
-// function, len(outputs) doesn't match number of function output values, or
-// generator for any of output values returns an error.
+// through "outputs" parameter. Generated function records the inputs it's called
+// with, and it's encoded (see [arbitrary.EncodeArbitraryToString]) as a table of recorded
+// calls. Function is shrunk by turning recorded calls into a finite input→output
+// table with default outputs for all other inputs, and by shrinking the table
+// (removing entries and shrinking their outputs) and default outputs. Error is
+// returned if generator's target is not a function, len(outputs) doesn't match
+// number of function output values, or generator for any of output values returns
+// an error.
 func Func(outputs ...arbitrary.Generator) arbitrary.Generator {
 	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
 		if target.Kind() != reflect.Func {
@@ -21,38 +81,90 @@ func Func(outputs ...arbitrary.Generator) arbitrary.Generator {
 		if len(outputs) != target.NumOut() {
 			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Invalid number of generators (%d) used for generating function outputs, expected %d", arbitrary.ErrorInvalidConfig, len(outputs), target.NumOut())
 		}
-		// arbitraries := make([]arbitrary.Arbitrary, len(outputs))
 		randoms := make([]arbitrary.Random, len(outputs))
 		for index := range outputs {
-			random := r.Split()
-			// arb, err := arb(target.Out(index), bias, random)
-			// if err != nil {
-			// 	return arbitrary.Arbitrary{}, fmt.Errorf("Can't use generator for generating output at index [%d]. %w", index, err)
-			// }
-			// arbitraries[index] = arb
-			randoms[index] = random
+			randoms[index] = r.Split()
 		}
 		randomInt64 := r.Uint64(constraints.Uint64Default())
-		// return func() (arbitrary.Arbitrary, shrinker.Shrinker) {
+
+		// generate generates function outputs with randoms seeded by "seed".
+		lock := sync.Mutex{}
+		generate := func(seed int64) (arbitrary.Arbitraries, error) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			arbitraries := make(arbitrary.Arbitraries, target.NumOut())
+			for index := range arbitraries {
+				randoms[index].Seed(seed)
+				arb, err := outputs[index](target.Out(index), bias, randoms[index])
+				if err != nil {
+					return nil, err
+				}
+				arbitraries[index] = arb
+			}
+			return arbitraries, nil
+		}
+
+		calls := &funcCalls{keys: map[string]struct{}{}}
+		value := reflect.MakeFunc(target, func(inputs []reflect.Value) []reflect.Value {
+			// In order to create 2 different pure functions that have the
+			// same signature but generate different ouput, random value is
+			// added to the hashed input parameters. This ensure that each
+			// function has differently seeded arbitrary.Random.
+			seed := int64(arbitrary.HashToInt64(inputs...)) + int64(randomInt64)
+			arbitraries, err := generate(seed)
+			if err != nil {
+				panic(err)
+			}
+			calls.record(inputs, arbitraries)
+
+			return arbitraries.Values()
+		})
+
 		return arbitrary.Arbitrary{
-			Value: reflect.MakeFunc(target, func(inputs []reflect.Value) []reflect.Value {
-				// In order to create 2 different pure functions that have the
-				// same signature but generate different ouput, random value is
-				// added to the hashed input parameters. This ensure that each
-				// function has differently seeded arbitrary.Random.
-				seed := int64(arbitrary.HashToInt64(inputs...)) + int64(randomInt64)
-				arbitraries := make(arbitrary.Arbitraries, target.NumOut())
-				for index := range arbitraries {
-					randoms[index].Seed(seed)
-					arb, err := outputs[index](target.Out(index), bias, randoms[index])
-					if err != nil {
-						panic(err)
-					}
-					arbitraries[index] = arb
+			Value: value,
+			Table: calls.table,
+			Shrinker: func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+				// Default outputs are generated using function's random value as a seed.
+				fallback, err := generate(int64(randomInt64))
+				if err != nil {
+					return arbitrary.Arbitrary{}, err
 				}
 
-				return arbitraries.Values()
-			}),
+				table := funcTable(target, calls.recorded(), fallback)
+				return table.Shrinker(table, propertyFailed)
+			},
 		}, nil
 	}
 }
+
+// funcTable returns arbitrary for function of "target" type defined by the table of
+// recorded "calls" and "fallback" outputs returned for all other inputs.
+func funcTable(target reflect.Type, calls []funcCall, fallback arbitrary.Arbitraries) arbitrary.Arbitrary {
+	entries := make(arbitrary.Arbitraries, len(calls))
+	for index, call := range calls {
+		inputs := make(arbitrary.Arbitraries, len(call.inputs))
+		for i, input := range call.inputs {
+			inputs[i] = arbitrary.Arbitrary{Value: input}
+		}
+
+		entries[index] = arbitrary.Arbitrary{
+			Precursors: inputs,
+			Elements:   call.outputs,
+		}
+		entries[index].Shrinker = shrinker.CollectionElements(entries[index])
+	}
+
+	table := arbitrary.Arbitrary{Elements: entries}
+	if len(entries) > 0 {
+		table.Shrinker = shrinker.Collection()
+	}
+	defaults := arbitrary.Arbitrary{Elements: fallback}
+	defaults.Shrinker = shrinker.CollectionElements(defaults)
+
+	arb := arbitrary.NewFunc(target)(arbitrary.Arbitrary{
+		Elements: arbitrary.Arbitraries{table, defaults},
+	})
+	arb.Shrinker = shrinker.Func(arb)
+	return arb
+}
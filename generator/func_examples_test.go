@@ -2,7 +2,9 @@ package generator_test
 
 import (
 	"fmt"
+	"reflect"
 
+	"github.com/steffnova/go-check/arbitrary"
 	"github.com/steffnova/go-check/constraints"
 	"github.com/steffnova/go-check/generator"
 )
@@ -42,3 +44,28 @@ func ExampleFunc() {
 	// []int{4, 3}
 	// []int{5, 5, 8, 10}
 }
+
+// This example demonstrates how functions generated by Func() generator are encoded. Generated
+// function records the inputs it's called with, and it's arbitrary is encoded as a table of
+// recorded calls.
+func ExampleFunc_table() {
+	generate := generator.Func(generator.Int(constraints.Int{Min: 0, Max: 10}))
+	target := reflect.TypeOf(func(string) int { return 0 })
+	random := arbitrary.NewRandomNumber(0)
+
+	for index := 0; index < 3; index++ {
+		arb, err := generate(target, constraints.Bias{Size: 3, Scaling: 3 - index}, random)
+		if err != nil {
+			panic(err)
+		}
+
+		f := arb.Value.Interface().(func(string) int)
+		f("a")
+		f("b")
+		fmt.Println(arbitrary.EncodeArbitraryToString(arb))
+	}
+	// Output:
+	// <func(string) int> func{a -> 0, b -> 2}
+	// <func(string) int> func{a -> 5, b -> 0}
+	// <func(string) int> func{a -> 6, b -> 6}
+}
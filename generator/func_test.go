@@ -2,9 +2,12 @@ package generator
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
 )
 
 func TestFunc(t *testing.T) {
@@ -59,6 +62,35 @@ func TestFunc(t *testing.T) {
 				t.Fatalf("Unexpected error; %s", err)
 			}
 		},
+		"EncodeRecordedCalls": func(t *testing.T) {
+			r := arbitrary.NewRandomNumber(0)
+			arb, err := Func(Bool())(reflect.TypeOf(func(int, int) bool { return false }), constraints.Bias{Size: 10, Scaling: 10}, r)
+			if err != nil {
+				t.Fatalf("Unexpected error; %s", err)
+			}
+
+			fn := arb.Value.Interface().(func(int, int) bool)
+			first, second := fn(1, 2), fn(3, 4)
+			fn(1, 2)
+
+			expected := fmt.Sprintf("<func(int, int) bool> func{(1, 2) -> %t, (3, 4) -> %t}", first, second)
+			if encoded := arbitrary.EncodeArbitraryToString(arb); encoded != expected {
+				t.Fatalf("Expected function to be encoded as: %s. Got: %s", expected, encoded)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrinkArbitrary(t, Func(Int()), reflect.TypeOf(func(int) int { return 0 }), func(v reflect.Value) bool {
+				fn := v.Interface().(func(int) int)
+				return fn(1) != fn(2)
+			})
+
+			// Function is shrunk to a table with a single entry, while the other input is
+			// mapped to the default output.
+			expected := "<func(int) int> func{1 -> 0, _ -> -1}"
+			if encoded := arbitrary.EncodeArbitraryToString(shrunk); encoded != expected {
+				t.Fatalf("Expected function to be shrunk to: %s. Got: %s", expected, encoded)
+			}
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,251 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
+)
+
+// Symbol is a part of grammar's production. Symbol is either a terminal, whose strings are
+// generated by a generator, or a nonterminal that references one of the grammar's rules.
+// Symbols are created with [Terminal], [Literal] and [NonTerminal] functions.
+type Symbol struct {
+	rule     string
+	terminal arbitrary.Generator
+}
+
+// Terminal returns terminal symbol whose strings are generated by generator specified by
+// "generator" parameter. Generator must be able to generate string values, for example
+// [StringMatching] generator can be used to define tokens of the language.
+func Terminal(generator arbitrary.Generator) Symbol {
+	return Symbol{terminal: generator}
+}
+
+// Literal returns terminal symbol that always generates the string specified by "literal"
+// parameter.
+func Literal(literal string) Symbol {
+	return Terminal(Constant(literal))
+}
+
+// NonTerminal returns nonterminal symbol that references grammar's rule specified by
+// "rule" parameter.
+func NonTerminal(rule string) Symbol {
+	return Symbol{rule: rule}
+}
+
+// Production is a sequence of symbols. String derived from production is a concatenation
+// of strings derived from each of it's symbols. Empty production derives an empty string.
+type Production []Symbol
+
+// Rules of the context-free grammar. Each rule is defined by it's name and productions
+// the rule can be replaced with.
+type Rules map[string][]Production
+
+// grammar holds grammar's rules and their minimal derivation heights.
+type grammar struct {
+	rules   Rules
+	heights map[string]uint
+}
+
+// Grammar returns generator for string types that generates sentences of context-free grammar
+// defined by "rules" parameter, starting from the rule specified by "start" parameter. Grammar's
+// rules are defined in BNF style: each rule has one or more productions and each production is a
+// sequence of symbols. EBNF's optional and repeated symbols can be defined as rules with an empty
+// production or recursive production respectively. Rules are derived the same way [Recursion]
+// generates recursive calls: rule's productions with minimal derivation height are the base case,
+// and the rest of them are the recursive case, which is less likely to be chosen the deeper the
+// rule is in the derivation tree. The "depth" parameter bounds the height of derivation tree: rule
+// that would exceed the depth is replaced only with productions that can be derived within
+// remaining depth. Generated sentences are shrunk by simplifying their derivation tree: rule's
+// derivation is replaced with derivation of the same rule nested in it, then with simpler
+// productions of the rule (base case productions, or productions declared before the chosen one),
+// and finally symbols of the chosen production are shrunk. Error is returned if generator's target
+// is not a string type, start rule or a rule referenced by nonterminal is not defined, rule has no
+// productions, rule can't derive a sentence without infinite recursion, depth is lower than the
+// derivation height of the start rule, or any of the terminal generators returns an error.
+func Grammar(start string, rules Rules, depth uint) arbitrary.Generator {
+	g, err := newGrammar(rules)
+	if err != nil {
+		return Invalid(fmt.Errorf("%w. %s", arbitrary.ErrorInvalidConfig, err))
+	}
+
+	switch height := g.heights[start]; {
+	case rules[start] == nil:
+		return Invalid(fmt.Errorf("%w. Start rule %s is not defined", arbitrary.ErrorInvalidConfig, start))
+	case height > depth:
+		return Invalid(fmt.Errorf("%w. Depth %d is lower than derivation height %d of start rule %s", arbitrary.ErrorInvalidConfig, depth, height, start))
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target.Kind() != reflect.String {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Grammar")
+		}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(""), target, func(in reflect.Value) reflect.Value {
+			return in.Convert(target)
+		})
+
+		return g.rule(start, depth, depth).Map(mapper)(target, bias, r)
+	}
+}
+
+// newGrammar validates grammar's rules and calculates minimal derivation height of
+// each rule.
+func newGrammar(rules Rules) (grammar, error) {
+	for name, productions := range rules {
+		if len(productions) == 0 {
+			return grammar{}, fmt.Errorf("rule %s has no productions", name)
+		}
+		for _, production := range productions {
+			for _, symbol := range production {
+				switch {
+				case symbol.terminal == nil && symbol.rule == "":
+					return grammar{}, fmt.Errorf("rule %s has a symbol that is neither terminal nor nonterminal", name)
+				case symbol.terminal == nil && rules[symbol.rule] == nil:
+					return grammar{}, fmt.Errorf("rule %s references undefined rule %s", name, symbol.rule)
+				}
+			}
+		}
+	}
+
+	g := grammar{
+		rules:   rules,
+		heights: map[string]uint{},
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for name, productions := range rules {
+			for _, production := range productions {
+				height, exists := g.height(production)
+				if current, defined := g.heights[name]; exists && (!defined || height < current) {
+					g.heights[name] = height
+					changed = true
+				}
+			}
+		}
+	}
+
+	for name := range rules {
+		if _, exists := g.heights[name]; !exists {
+			return grammar{}, fmt.Errorf("rule %s can't be derived without infinite recursion", name)
+		}
+	}
+
+	return g, nil
+}
+
+// height returns derivation height of the production and bool value that indicates
+// whether derivation heights of all rules referenced by production are known.
+func (g grammar) height(production Production) (uint, bool) {
+	height := uint(1)
+	for _, symbol := range production {
+		if symbol.terminal != nil {
+			continue
+		}
+		ruleHeight, exists := g.heights[symbol.rule]
+		if !exists {
+			return 0, false
+		}
+		if ruleHeight+1 > height {
+			height = ruleHeight + 1
+		}
+	}
+	return height, true
+}
+
+// rule returns generator of strings derived from the rule within "remaining" depth of total
+// grammar's "depth". Rule is derived with [Recursion]'s machinery, where rule's productions with
+// minimal derivation height are the base case, and the rest of the productions that can be derived
+// within remaining depth are the recursive case. Productions of each case are ordered by their
+// derivation height, and each of them is chosen with equal probability.
+func (g grammar) rule(name string, remaining, depth uint) arbitrary.Generator {
+	base, recursive := []arbitrary.Generator{}, []arbitrary.Generator{}
+	for _, production := range g.productions(name, remaining) {
+		generator := g.production(name, production, remaining, depth)
+		if height, _ := g.height(production); height == g.heights[name] {
+			base = append(base, generator)
+		} else {
+			recursive = append(recursive, generator)
+		}
+	}
+
+	constraint := constraints.Recursive{
+		Depth:     depth,
+		Frequency: constraints.RecursiveDefault().Frequency,
+	}
+
+	generator := recursion(name, constraint, depth-remaining, OneFrom(base[0], base[1:]...), func() arbitrary.Generator {
+		if len(recursive) == 0 {
+			return nil
+		}
+		return OneFrom(recursive[0], recursive[1:]...)
+	})
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		arb, err := generator(target, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, fmt.Errorf("failed to derive rule %s. %w", name, err)
+		}
+		return arb, nil
+	}
+}
+
+// productions returns rule's productions that can be derived within remaining depth, ordered
+// by their derivation height.
+func (g grammar) productions(name string, remaining uint) []Production {
+	productions := []Production{}
+	for _, production := range g.rules[name] {
+		if height, _ := g.height(production); height <= remaining {
+			productions = append(productions, production)
+		}
+	}
+	sort.SliceStable(productions, func(i, j int) bool {
+		hi, _ := g.height(productions[i])
+		hj, _ := g.height(productions[j])
+		return hi < hj
+	})
+	return productions
+}
+
+// production returns generator of strings derived from production of the rule. Rules
+// referenced by production's nonterminals are derived within remaining depth lowered by 1.
+func (g grammar) production(name string, production Production, remaining, depth uint) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		elements := make(arbitrary.Arbitraries, len(production))
+		for index, symbol := range production {
+			generator := symbol.terminal
+			if generator == nil {
+				generator = g.rule(symbol.rule, remaining-1, depth)
+			}
+
+			element, err := generator(target, bias, r)
+			if err != nil {
+				return arbitrary.Arbitrary{}, fmt.Errorf("failed to generate symbol with index %d of rule %s. %w", index, name, err)
+			}
+			elements[index] = element
+		}
+
+		arb := grammarConcat(arbitrary.Arbitrary{
+			Elements: elements,
+		})
+		arb.Shrinker = shrinker.CollectionElements(arb).TransformAfter(grammarConcat)
+
+		return arb, nil
+	}
+}
+
+// grammarConcat sets arbitrary's value to concatenation of it's elements' values.
+func grammarConcat(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+	builder := strings.Builder{}
+	for _, element := range arb.Elements {
+		builder.WriteString(element.Value.String())
+	}
+	arb.Value = reflect.ValueOf(builder.String())
+	return arb
+}
@@ -0,0 +1,58 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Grammar() generator for generation of sentences of a
+// small query language. Tokens of the language are generated with StringMatching() generator.
+func ExampleGrammar() {
+	rules := generator.Rules{
+		"query": {
+			{generator.Literal("SELECT "), generator.NonTerminal("fields"), generator.Literal(" FROM "), generator.NonTerminal("name")},
+			{generator.Literal("SELECT "), generator.NonTerminal("fields"), generator.Literal(" FROM "), generator.NonTerminal("name"), generator.Literal(" WHERE "), generator.NonTerminal("condition")},
+		},
+		"fields": {
+			{generator.Literal("*")},
+			{generator.NonTerminal("names")},
+		},
+		"names": {
+			{generator.NonTerminal("name")},
+			{generator.NonTerminal("name"), generator.Literal(", "), generator.NonTerminal("names")},
+		},
+		"condition": {
+			{generator.NonTerminal("name"), generator.Terminal(generator.StringMatching(" (=|<|>) ")), generator.NonTerminal("value")},
+			{generator.NonTerminal("condition"), generator.Terminal(generator.StringMatching(" (AND|OR) ")), generator.NonTerminal("condition")},
+		},
+		"name": {
+			{generator.Terminal(generator.StringMatching("[a-z]{1,5}"))},
+		},
+		"value": {
+			{generator.Terminal(generator.StringMatching("[0-9]{1,3}|'[a-z]*'"))},
+		},
+	}
+
+	streamer := generator.Streamer(
+		func(query string) {
+			fmt.Println(query)
+		},
+		generator.Grammar("query", rules, 5),
+	)
+
+	if err := generator.Stream(0, 10, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// SELECT * FROM fw WHERE vhszm > 39 OR g = 'putcbnm' OR qka < 4
+	// SELECT mc, c FROM rglsl WHERE zi < 'sgjpwk' AND ku < 102 AND w < 'kpzrtfrsq' AND myyfq = 014
+	// SELECT * FROM ku WHERE c = 'gpxtgdq' AND nlele > 36 AND q = 008 AND hs < 13
+	// SELECT * FROM gfu
+	// SELECT hut FROM bkyw WHERE bi < 3 AND zpcco = 'zpqivku' OR ud = 'skxpx'
+	// SELECT vtgwd, z FROM emvm WHERE bkx < 35 AND wq = 45 OR xkwwc < 'ixcz' OR rcdo = 0
+	// SELECT * FROM kxhf WHERE r > ''
+	// SELECT * FROM nve WHERE fzxfe < 95 OR n > 98 OR u < 'rshlvedr' OR cf > 'jtnizol'
+	// SELECT * FROM mfup WHERE yus < 81
+	// SELECT gx FROM vffum WHERE mpmjo = 22 OR oarq = 10
+}
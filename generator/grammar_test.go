@@ -0,0 +1,162 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+func TestGrammar(t *testing.T) {
+	// expression grammar: expr = term | expr "+" term; term = num | "(" expr ")"
+	rules := Rules{
+		"expr": {
+			{NonTerminal("term")},
+			{NonTerminal("expr"), Literal("+"), NonTerminal("term")},
+		},
+		"term": {
+			{NonTerminal("num")},
+			{Literal("("), NonTerminal("expr"), Literal(")")},
+		},
+		"num": {
+			{Terminal(StringMatching("[0-9]{1,3}"))},
+		},
+	}
+
+	// parse reports whether s is a valid expression, returning unparsed remainder.
+	var parseExpr, parseTerm func(s string) (string, bool)
+	parseTerm = func(s string) (string, bool) {
+		if strings.HasPrefix(s, "(") {
+			rest, ok := parseExpr(s[1:])
+			if !ok || !strings.HasPrefix(rest, ")") {
+				return s, false
+			}
+			return rest[1:], true
+		}
+		digits := len(s) - len(strings.TrimLeft(s, "0123456789"))
+		return s[digits:], digits >= 1 && digits <= 3
+	}
+	parseExpr = func(s string) (string, bool) {
+		rest, ok := parseTerm(s)
+		for ok && strings.HasPrefix(rest, "+") {
+			rest, ok = parseTerm(rest[1:])
+		}
+		return rest, ok
+	}
+
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(int) {},
+				Grammar("expr", rules, 5),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidGrammar": func(t *testing.T) {
+			grammars := map[string]Rules{
+				"UndefinedStartRule": {"a": {{Literal("a")}}},
+				"UndefinedRule":      {"start": {{NonTerminal("a")}}},
+				"NoProductions":      {"start": {}},
+				"InvalidSymbol":      {"start": {{Symbol{}}}},
+				"InfiniteRecursion":  {"start": {{Literal("a"), NonTerminal("start")}}},
+			}
+
+			for name, rules := range grammars {
+				err := Stream(0, 10, Streamer(
+					func(string) {},
+					Grammar("start", rules, 5),
+				))
+
+				if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+					t.Fatalf("%s: Expected error: '%s'", name, arbitrary.ErrorInvalidConfig)
+				}
+			}
+		},
+		"DepthTooLow": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(string) {},
+				Grammar("expr", rules, 2),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConfig)
+			}
+		},
+		"Sentences": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(s string) {
+					if rest, ok := parseExpr(s); !ok || rest != "" {
+						t.Fatalf("Invalid expression: %q", s)
+					}
+				},
+				Grammar("expr", rules, 8),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"DepthBound": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(s string) {
+					if strings.Contains(s, "(") {
+						t.Fatalf("Expression %q exceeds depth", s)
+					}
+				},
+				Grammar("expr", rules, 4),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"EmptyProduction": func(t *testing.T) {
+			list := Rules{
+				"list": {
+					{},
+					{Literal("x"), NonTerminal("list")},
+				},
+			}
+
+			err := Stream(0, 100, Streamer(
+				func(s string) {
+					if strings.Trim(s, "x") != "" || len(s) > 5 {
+						t.Fatalf("Invalid list: %q", s)
+					}
+				},
+				Grammar("list", list, 6),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"ShrinkToSimplestSentence": func(t *testing.T) {
+			shrunk := shrink(t, Grammar("expr", rules, 8), reflect.TypeOf(""), func(reflect.Value) bool {
+				return true
+			})
+
+			if shrunk.String() != "0" {
+				t.Fatalf("Expected sentence to be shrunk to: 0. Got: %s", shrunk)
+			}
+		},
+		"ShrinkDerivationTree": func(t *testing.T) {
+			shrunk := shrink(t, Grammar("expr", rules, 8), reflect.TypeOf(""), func(v reflect.Value) bool {
+				return strings.Contains(v.String(), "+")
+			})
+
+			if shrunk.String() != "0+0" {
+				t.Fatalf("Expected sentence to be shrunk to: 0+0. Got: %s", shrunk)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
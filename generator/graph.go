@@ -0,0 +1,290 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
+)
+
+// Digraph is a directed graph generated by [Graph] generator. Nodes holds the values of
+// graph's nodes, while Edges holds graph's edges, that reference nodes by their index.
+// Graph has no self loops and no parallel edges.
+type Digraph[N, E any] struct {
+	Nodes []N
+	Edges []Edge[E]
+}
+
+// Edge is an edge of a [Digraph] that goes from node with index From to node with
+// index To, and has a value.
+type Edge[E any] struct {
+	From  int
+	To    int
+	Value E
+}
+
+// Adjacency returns graph's adjacency list, where n-th element holds indexes of the nodes
+// that edges of n-th node go to.
+func (g Digraph[N, E]) Adjacency() [][]int {
+	adjacency := make([][]int, len(g.Nodes))
+	for _, edge := range g.Edges {
+		adjacency[edge.From] = append(adjacency[edge.From], edge.To)
+	}
+	return adjacency
+}
+
+// graph is implemented by [Digraph] types.
+func (g Digraph[N, E]) graph() {}
+
+// Graph returns generator for [Digraph] types. Node values are generated by "node" generator and
+// edge values are generated by "edge" generator. Number of nodes, density of edges and whether
+// graph is acyclic or connected is defined by "limits" parameter. The "limits" parameter, even
+// though it is variadic, evaluates only the first instance of [constraints.Graph]. If limits are
+// omitted, [constraints.GraphDefault] is used instead. Number of nodes is generated first, and
+// each of the possible edges between generated nodes exists with limits.Density percent chance.
+// When graph is acyclic, edges always go from nodes with lower index to nodes with higher index.
+// When graph is connected each node, except the first one, is connected to one of the nodes with
+// lower index, and such graph with density of 0 is a tree. Graphs are shrunk by removing nodes
+// together with their edges, by removing edges, and by shrinking their values, while graph
+// remains acyclic and connected. Edges that remain after a node is removed connect the same
+// nodes as before. Error is returned if generator's target is not a Digraph type, limits are
+// invalid, or node or edge generators return an error.
+func Graph(node, edge arbitrary.Generator, limits ...constraints.Graph) arbitrary.Generator {
+	constraint := constraints.GraphDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		switch {
+		case target.Kind() != reflect.Struct || !target.Implements(reflect.TypeOf((*interface{ graph() })(nil)).Elem()):
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Graph")
+		case constraint.Nodes.Min > constraint.Nodes.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal number of nodes %d can't be greater than max number of nodes %d", arbitrary.ErrorInvalidConstraints, constraint.Nodes.Min, constraint.Nodes.Max)
+		case constraint.Nodes.Max > uint64(math.MaxInt32):
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Max number of nodes %d can't be greater than %d", arbitrary.ErrorInvalidConstraints, constraint.Nodes.Max, math.MaxInt32)
+		case constraint.Density > 100:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Density %d can't be greater than 100", arbitrary.ErrorInvalidConstraints, constraint.Density)
+		}
+
+		nodesType, _ := target.FieldByName("Nodes")
+		edgesType, _ := target.FieldByName("Edges")
+		edgeType, _ := edgesType.Type.Elem().FieldByName("Value")
+
+		values, err := Slice(node, constraint.Nodes)(nodesType.Type, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, fmt.Errorf("Failed to generate graph's nodes. %w", err)
+		}
+
+		// Nodes are identified by the index they are generated with, which edges use to
+		// reference them, so that removing a node doesn't change nodes other edges connect.
+		nodes := arbitrary.Arbitrary{Elements: make(arbitrary.Arbitraries, len(values.Elements))}
+		for index, value := range values.Elements {
+			nodes.Elements[index] = graphElement(reflect.ValueOf(index), value)
+		}
+
+		edges := arbitrary.Arbitrary{}
+		addEdge := func(from, to int) error {
+			value, err := edge(edgeType.Type, bias, r)
+			if err != nil {
+				return fmt.Errorf("Failed to generate graph's edge. %w", err)
+			}
+			edges.Elements = append(edges.Elements, graphElement(reflect.ValueOf([2]int{from, to}), value))
+			return nil
+		}
+
+		for to := range nodes.Elements {
+			parent := -1
+			if constraint.Connected && to > 0 {
+				parent = int(r.Uint64(constraints.Uint64{Min: 0, Max: uint64(to - 1)}))
+				if err := addEdge(parent, to); err != nil {
+					return arbitrary.Arbitrary{}, err
+				}
+			}
+			if constraint.Density == 0 {
+				continue
+			}
+
+			for from := range nodes.Elements {
+				switch {
+				case from == to || from == parent:
+				case constraint.Acyclic && from > to:
+				case r.Uint64(constraints.Uint64{Min: 1, Max: 100}) > constraint.Density:
+				default:
+					if err := addEdge(from, to); err != nil {
+						return arbitrary.Arbitrary{}, err
+					}
+				}
+			}
+		}
+
+		// graphRemoval returns shrinker that removes elements of graph's collection at
+		// "collection" index, starting from the last one.
+		graphRemoval := func(collection int) arbitrary.Shrinker {
+			return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+				last := len(arb.Elements[collection].Elements) - 1
+				return graphRemove(last, func(arb arbitrary.Arbitrary, index int) (arbitrary.Arbitrary, bool) {
+					reduced := graphWithout(arb, collection, index)
+					switch {
+					case uint64(len(reduced.Elements[0].Elements)) < constraint.Nodes.Min:
+						return arb, false
+					case constraint.Connected && !graphConnected(reduced):
+						return arb, false
+					default:
+						return reduced, true
+					}
+				})(arb, propertyFailed)
+			}
+		}
+
+		arb := graphValue(target)(arbitrary.Arbitrary{
+			Elements: arbitrary.Arbitraries{nodes, edges},
+		})
+		arb.Shrinker = shrinker.Chain(
+			graphRemoval(0),
+			graphRemoval(1),
+			graphValues,
+		).TransformAfter(graphValue(target))
+
+		return arb, nil
+	}
+}
+
+// graphElement returns arbitrary for graph's node or edge, whose value identifies it (index
+// of the node, or indexes of the nodes edge connects) and whose only element is it's value.
+func graphElement(id reflect.Value, value arbitrary.Arbitrary) arbitrary.Arbitrary {
+	element := arbitrary.Arbitrary{
+		Value:    id,
+		Elements: arbitrary.Arbitraries{value},
+	}
+	element.Shrinker = shrinker.CollectionElements(element)
+	return element
+}
+
+// graphWithout returns graph arbitrary without the element at "index" of collection at
+// "collection" index (0 for nodes and 1 for edges). When node is removed, it's edges are
+// removed as well.
+func graphWithout(arb arbitrary.Arbitrary, collection, index int) arbitrary.Arbitrary {
+	nodes, edges := arb.Elements[0], arb.Elements[1]
+
+	removed := -1
+	if collection == 0 {
+		removed = int(nodes.Elements[index].Value.Int())
+		nodes.Elements = append(append(arbitrary.Arbitraries{}, nodes.Elements[:index]...), nodes.Elements[index+1:]...)
+	}
+
+	kept := make(arbitrary.Arbitraries, 0, len(edges.Elements))
+	for position, edge := range edges.Elements {
+		ids := edge.Value.Interface().([2]int)
+		switch {
+		case collection == 1 && position == index:
+		case ids[0] == removed || ids[1] == removed:
+		default:
+			kept = append(kept, edge)
+		}
+	}
+	edges.Elements = kept
+
+	arb.Elements = arbitrary.Arbitraries{nodes, edges}
+	return arb
+}
+
+// graphRemove returns shrinker that removes graph's nodes or edges with "remove", starting
+// from the one at "index" towards the first one. Removal is kept if property is falsified
+// without the removed element, and removals that would make graph invalid are skipped.
+func graphRemove(index int, remove func(arbitrary.Arbitrary, int) (arbitrary.Arbitrary, bool)) arbitrary.Shrinker {
+	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		for index := index; index >= 0; index-- {
+			reduced, ok := remove(arb, index)
+			if !ok {
+				continue
+			}
+
+			revert := func(arbitrary.Arbitrary) arbitrary.Arbitrary {
+				return arb
+			}
+			reduced.Shrinker = graphRemove(index-1, remove).
+				Or(graphRemove(index-1, remove).TransformOnceBefore(revert))
+			return reduced, nil
+		}
+
+		arb.Shrinker = nil
+		return arb, nil
+	}
+}
+
+// graphValues shrinks values of graph's nodes and edges.
+func graphValues(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+	nodes, edges := arb.Elements[0], arb.Elements[1]
+	nodes.Shrinker = shrinker.CollectionElements(nodes)
+	edges.Shrinker = shrinker.CollectionElements(edges)
+
+	arb.Elements = arbitrary.Arbitraries{nodes, edges}
+	return shrinker.CollectionElements(arb)(arb, propertyFailed)
+}
+
+// graphConnected returns true if graph is weakly connected.
+func graphConnected(arb arbitrary.Arbitrary) bool {
+	nodes, edges := arb.Elements[0].Elements, arb.Elements[1].Elements
+	if len(nodes) == 0 {
+		return true
+	}
+
+	neighbours := map[int][]int{}
+	for _, edge := range edges {
+		ids := edge.Value.Interface().([2]int)
+		neighbours[ids[0]] = append(neighbours[ids[0]], ids[1])
+		neighbours[ids[1]] = append(neighbours[ids[1]], ids[0])
+	}
+
+	first := int(nodes[0].Value.Int())
+	visited, stack := map[int]bool{first: true}, []int{first}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, next := range neighbours[node] {
+			if !visited[next] {
+				visited[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+	return len(visited) == len(nodes)
+}
+
+// graphValue returns a transformation that sets arbitrary's value to a graph of "target"
+// type, built from arbitrary's nodes and edges. Edges reference nodes by their index in
+// the graph, found by the ids of the nodes they connect.
+func graphValue(target reflect.Type) func(arbitrary.Arbitrary) arbitrary.Arbitrary {
+	nodesType, _ := target.FieldByName("Nodes")
+	edgesType, _ := target.FieldByName("Edges")
+
+	return func(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+		nodes, edges := arb.Elements[0].Elements, arb.Elements[1].Elements
+
+		indexes := make(map[int]int, len(nodes))
+		values := reflect.MakeSlice(nodesType.Type, len(nodes), len(nodes))
+		for index, node := range nodes {
+			indexes[int(node.Value.Int())] = index
+			values.Index(index).Set(node.Elements[0].Value)
+		}
+
+		list := reflect.MakeSlice(edgesType.Type, len(edges), len(edges))
+		for index, edge := range edges {
+			ids := edge.Value.Interface().([2]int)
+			list.Index(index).FieldByName("From").SetInt(int64(indexes[ids[0]]))
+			list.Index(index).FieldByName("To").SetInt(int64(indexes[ids[1]]))
+			list.Index(index).FieldByName("Value").Set(edge.Elements[0].Value)
+		}
+
+		graph := reflect.New(target).Elem()
+		graph.FieldByName("Nodes").Set(values)
+		graph.FieldByName("Edges").Set(list)
+
+		arb.Value = graph
+		return arb
+	}
+}
@@ -0,0 +1,68 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Graph() generator for generation of directed acyclic
+// graphs, whose nodes are int values and whose edges have no values. Graph is printed as its
+// nodes and adjacency list.
+func ExampleGraph() {
+	streamer := generator.Streamer(
+		func(g generator.Digraph[int, struct{}]) {
+			fmt.Println(g.Nodes, g.Adjacency())
+		},
+		generator.Graph(
+			generator.Int(constraints.Int{Min: 0, Max: 9}),
+			generator.Struct(map[string]arbitrary.Generator{}),
+			constraints.Graph{
+				Nodes:   constraints.Length{Min: 1, Max: 5},
+				Density: 30,
+				Acyclic: true,
+			},
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// [6 0 0] [[] [2] []]
+	// [3 0 3 1 8] [[] [2] [] [] []]
+	// [9 9 4 9] [[] [] [3] []]
+	// [4 9 8 0] [[2 3] [2] [] []]
+	// [2 1 6 4] [[3] [3] [] []]
+}
+
+// This example demonstrates how to use Graph() generator for generation of trees, which are
+// connected acyclic graphs with density of 0. Edge values are the weights of the edges.
+func ExampleGraph_tree() {
+	streamer := generator.Streamer(
+		func(g generator.Digraph[string, uint]) {
+			fmt.Println(g.Nodes, g.Edges)
+		},
+		generator.Graph(
+			generator.StringMatching("[a-z]"),
+			generator.Uint(constraints.Uint{Min: 1, Max: 9}),
+			constraints.Graph{
+				Nodes:     constraints.Length{Min: 1, Max: 5},
+				Acyclic:   true,
+				Connected: true,
+			},
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// [w q q] [{0 1 2} {1 2 7}]
+	// [d a d n r] [{0 1 9} {1 2 6} {2 3 3} {0 4 6}]
+	// [j j u j] [{0 1 2} {0 2 8} {0 3 5}]
+	// [y o] [{0 1 1}]
+	// [m x] [{0 1 4}]
+}
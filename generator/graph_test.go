@@ -0,0 +1,231 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestGraph(t *testing.T) {
+	type graph = Digraph[int, uint]
+
+	// cyclic returns true if graph has a directed cycle.
+	cyclic := func(g graph) bool {
+		adjacency, state := g.Adjacency(), make([]int, len(g.Nodes))
+		var visit func(int) bool
+		visit = func(node int) bool {
+			state[node] = 1
+			for _, next := range adjacency[node] {
+				if state[next] == 1 || (state[next] == 0 && visit(next)) {
+					return true
+				}
+			}
+			state[node] = 2
+			return false
+		}
+		for node := range g.Nodes {
+			if state[node] == 0 && visit(node) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// connected returns true if graph is weakly connected.
+	connected := func(g graph) bool {
+		if len(g.Nodes) == 0 {
+			return true
+		}
+		neighbours := make([][]int, len(g.Nodes))
+		for _, edge := range g.Edges {
+			neighbours[edge.From] = append(neighbours[edge.From], edge.To)
+			neighbours[edge.To] = append(neighbours[edge.To], edge.From)
+		}
+		visited, stack := map[int]bool{0: true}, []int{0}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, next := range neighbours[node] {
+				if !visited[next] {
+					visited[next] = true
+					stack = append(stack, next)
+				}
+			}
+		}
+		return len(visited) == len(g.Nodes)
+	}
+
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(func(*graph) {}, Graph(Int(), Uint())))
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidTarget, err)
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			for _, limits := range []constraints.Graph{
+				{Nodes: constraints.Length{Min: 2, Max: 1}},
+				{Nodes: constraints.Length{Min: 0, Max: 1 << 40}},
+				{Nodes: constraints.Length{Min: 0, Max: 10}, Density: 101},
+			} {
+				err := Stream(0, 10, Streamer(func(graph) {}, Graph(Int(), Uint(), limits)))
+				if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+					t.Fatalf("Expected error: '%s'. Got: %v", arbitrary.ErrorInvalidConstraints, err)
+				}
+			}
+		},
+		"SimpleGraph": func(t *testing.T) {
+			limits := constraints.Graph{Nodes: constraints.Length{Min: 2, Max: 8}, Density: 50}
+
+			err := Stream(0, 100, Streamer(
+				func(g graph) {
+					if len(g.Nodes) < 2 || len(g.Nodes) > 8 {
+						t.Fatalf("Number of nodes %d is out of range [2, 8]", len(g.Nodes))
+					}
+					edges := map[[2]int]bool{}
+					for _, edge := range g.Edges {
+						switch {
+						case edge.From < 0 || edge.From >= len(g.Nodes) || edge.To < 0 || edge.To >= len(g.Nodes):
+							t.Fatalf("Edge %v references node out of range", edge)
+						case edge.From == edge.To:
+							t.Fatalf("Unexpected self loop: %v", edge)
+						case edges[[2]int{edge.From, edge.To}]:
+							t.Fatalf("Unexpected parallel edge: %v", edge)
+						}
+						edges[[2]int{edge.From, edge.To}] = true
+					}
+				},
+				Graph(Int(), Uint(), limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"NoEdges": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(g graph) {
+					if len(g.Edges) != 0 {
+						t.Fatalf("Expected graph without edges. Got: %v", g.Edges)
+					}
+				},
+				Graph(Int(), Uint(), constraints.Graph{Nodes: constraints.Length{Min: 0, Max: 10}}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Acyclic": func(t *testing.T) {
+			limits := constraints.Graph{Nodes: constraints.Length{Min: 0, Max: 10}, Density: 50, Acyclic: true}
+
+			err := Stream(0, 100, Streamer(
+				func(g graph) {
+					if cyclic(g) {
+						t.Fatalf("Unexpected cycle in graph: %v", g.Edges)
+					}
+				},
+				Graph(Int(), Uint(), limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Connected": func(t *testing.T) {
+			limits := constraints.Graph{Nodes: constraints.Length{Min: 0, Max: 10}, Density: 10, Connected: true}
+
+			err := Stream(0, 100, Streamer(
+				func(g graph) {
+					if !connected(g) {
+						t.Fatalf("Graph is not connected: %v", g.Edges)
+					}
+				},
+				Graph(Int(), Uint(), limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Tree": func(t *testing.T) {
+			limits := constraints.Graph{Nodes: constraints.Length{Min: 1, Max: 10}, Acyclic: true, Connected: true}
+
+			err := Stream(0, 100, Streamer(
+				func(g graph) {
+					if !connected(g) || cyclic(g) || len(g.Edges) != len(g.Nodes)-1 {
+						t.Fatalf("Graph is not a tree: %v", g.Edges)
+					}
+				},
+				Graph(Int(), Uint(), limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"ShrinkToCycle": func(t *testing.T) {
+			limits := constraints.Graph{Nodes: constraints.Length{Min: 0, Max: 10}, Density: 50}
+
+			shrunk := shrink(t, Graph(Int(), Uint(), limits), reflect.TypeOf(graph{}), func(v reflect.Value) bool {
+				return cyclic(v.Interface().(graph))
+			}).Interface().(graph)
+
+			if len(shrunk.Edges) != len(shrunk.Nodes) {
+				t.Fatalf("Expected graph to be shrunk to a simple cycle. Got: %v", shrunk)
+			}
+			for _, value := range shrunk.Nodes {
+				if value != 0 {
+					t.Fatalf("Expected node values to be shrunk to 0. Got: %v", shrunk.Nodes)
+				}
+			}
+			for _, edge := range shrunk.Edges {
+				if edge.Value != 0 {
+					t.Fatalf("Expected edge values to be shrunk to 0. Got: %v", shrunk.Edges)
+				}
+			}
+		},
+		"ShrinkKeepsInvariants": func(t *testing.T) {
+			limits := constraints.Graph{Nodes: constraints.Length{Min: 0, Max: 10}, Density: 50, Acyclic: true, Connected: true}
+
+			shrunk := shrink(t, Graph(Int(), Uint(), limits), reflect.TypeOf(graph{}), func(v reflect.Value) bool {
+				g := v.Interface().(graph)
+				if cyclic(g) || !connected(g) {
+					t.Fatalf("Shrunk graph is not acyclic and connected: %v", g.Edges)
+				}
+				return len(g.Nodes) >= 3
+			}).Interface().(graph)
+
+			if len(shrunk.Nodes) != 3 || len(shrunk.Edges) != 2 {
+				t.Fatalf("Expected graph to be shrunk to 3 nodes and 2 edges. Got: %v", shrunk)
+			}
+		},
+		"ShrinkRemovesNodesWithTheirEdges": func(t *testing.T) {
+			limits := constraints.Graph{Nodes: constraints.Length{Min: 0, Max: 10}, Density: 50}
+
+			// Property fails if there is an edge from a positive to a negative node, which
+			// holds only if removed nodes don't rewire remaining edges.
+			shrunk := shrink(t, Graph(Int(), Uint(), limits), reflect.TypeOf(graph{}), func(v reflect.Value) bool {
+				g := v.Interface().(graph)
+				for _, edge := range g.Edges {
+					if g.Nodes[edge.From] > 0 && g.Nodes[edge.To] < 0 {
+						return true
+					}
+				}
+				return false
+			}).Interface().(graph)
+
+			expected := graph{Nodes: []int{1, -1}, Edges: []Edge[uint]{{From: 0, To: 1, Value: 0}}}
+			if !reflect.DeepEqual(shrunk, expected) {
+				t.Fatalf("Expected graph to be shrunk to %v. Got: %v", expected, shrunk)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -19,13 +19,15 @@ func Int64(limits ...constraints.Int64) arbitrary.Generator {
 	}
 
 	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
-		negativeMapper := arbitrary.Mapper(reflect.TypeOf(uint64(0)), target, func(in reflect.Value) reflect.Value {
+		negative := func(in reflect.Value) reflect.Value {
 			return reflect.ValueOf(int64(-in.Uint())).Convert(target)
-		})
+		}
 
-		positiveMapper := arbitrary.Mapper(reflect.TypeOf(uint64(0)), target, func(in reflect.Value) reflect.Value {
+		positive := func(in reflect.Value) reflect.Value {
 			return reflect.ValueOf(int64(in.Uint())).Convert(target)
-		})
+		}
+
+		uint64Type := reflect.TypeOf(uint64(0))
 
 		switch {
 		case target.Kind() != reflect.Int64:
@@ -33,18 +35,14 @@ func Int64(limits ...constraints.Int64) arbitrary.Generator {
 		case constraint.Min > constraint.Max:
 			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Lower limit: %d cannot be greater than upper limit: %d", arbitrary.ErrorInvalidConstraints, constraint.Min, constraint.Max)
 		case constraint.Max < 0:
-			return Uint64(constraints.Uint64{Min: uint64(-constraint.Max), Max: uint64(-constraint.Min)}).
-				Map(negativeMapper)(target, bias, r)
+			return convert(Uint64(constraints.Uint64{Min: uint64(-constraint.Max), Max: uint64(-constraint.Min)}), uint64Type, negative)(target, bias, r)
 		case constraint.Min >= 0:
-			return Uint64(constraints.Uint64{Min: uint64(constraint.Min), Max: uint64(constraint.Max)}).
-				Map(positiveMapper)(target, bias, r)
+			return convert(Uint64(constraints.Uint64{Min: uint64(constraint.Min), Max: uint64(constraint.Max)}), uint64Type, positive)(target, bias, r)
 		default:
-			return Weighted(
+			return branch(
 				[]uint64{uint64(-(constraint.Min)), uint64(constraint.Max) + 1},
-				Uint64(constraints.Uint64{Min: 0, Max: uint64(-constraint.Min)}).
-					Map(negativeMapper),
-				Uint64(constraints.Uint64{Min: 0, Max: uint64(constraint.Max)}).
-					Map(positiveMapper),
+				convert(Uint64(constraints.Uint64{Min: 0, Max: uint64(-constraint.Min)}), uint64Type, negative),
+				convert(Uint64(constraints.Uint64{Min: 0, Max: uint64(constraint.Max)}), uint64Type, positive),
 			)(target, bias, r)
 		}
 	}
@@ -65,13 +63,13 @@ func Int32(limits ...constraints.Int32) arbitrary.Generator {
 			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Int32")
 		}
 
-		mapper := arbitrary.Mapper(reflect.TypeOf(int64(0)), target, func(in reflect.Value) reflect.Value {
-			return reflect.ValueOf(int32(in.Int())).Convert(target)
-		})
-		return Int64(constraints.Int64{
+		convertFn := func(in reflect.Value) reflect.Value {
+			return in.Convert(target)
+		}
+		return convert(Int64(constraints.Int64{
 			Min: int64(constraint.Min),
 			Max: int64(constraint.Max),
-		}).Map(mapper)(target, bias, r)
+		}), reflect.TypeOf(int64(0)), convertFn)(target, bias, r)
 	}
 }
 
@@ -89,13 +87,13 @@ func Int16(limits ...constraints.Int16) arbitrary.Generator {
 			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Int16")
 		}
 
-		mapper := arbitrary.Mapper(reflect.TypeOf(int64(0)), target, func(in reflect.Value) reflect.Value {
-			return reflect.ValueOf(int16(in.Int())).Convert(target)
-		})
-		return Int64(constraints.Int64{
+		convertFn := func(in reflect.Value) reflect.Value {
+			return in.Convert(target)
+		}
+		return convert(Int64(constraints.Int64{
 			Min: int64(constraint.Min),
 			Max: int64(constraint.Max),
-		}).Map(mapper)(target, bias, r)
+		}), reflect.TypeOf(int64(0)), convertFn)(target, bias, r)
 	}
 }
 
@@ -114,13 +112,13 @@ func Int8(limits ...constraints.Int8) arbitrary.Generator {
 			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Int8")
 		}
 
-		mapper := arbitrary.Mapper(reflect.TypeOf(int64(0)), target, func(in reflect.Value) reflect.Value {
-			return reflect.ValueOf(int8(in.Int())).Convert(target)
-		})
-		return Int64(constraints.Int64{
+		convertFn := func(in reflect.Value) reflect.Value {
+			return in.Convert(target)
+		}
+		return convert(Int64(constraints.Int64{
 			Min: int64(constraint.Min),
 			Max: int64(constraint.Max),
-		}).Map(mapper)(target, bias, r)
+		}), reflect.TypeOf(int64(0)), convertFn)(target, bias, r)
 	}
 }
 
@@ -139,12 +137,12 @@ func Int(limits ...constraints.Int) arbitrary.Generator {
 			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Int")
 		}
 
-		mapper := arbitrary.Mapper(reflect.TypeOf(int64(0)), target, func(in reflect.Value) reflect.Value {
-			return reflect.ValueOf(int(in.Int())).Convert(target)
-		})
-		return Int64(constraints.Int64{
+		convertFn := func(in reflect.Value) reflect.Value {
+			return in.Convert(target)
+		}
+		return convert(Int64(constraints.Int64{
 			Min: int64(constraint.Min),
 			Max: int64(constraint.Max),
-		}).Map(mapper)(target, bias, r)
+		}), reflect.TypeOf(int64(0)), convertFn)(target, bias, r)
 	}
 }
@@ -20,16 +20,16 @@ func ExampleInt() {
 		panic(err)
 	}
 	// Output:
-	// -4518808235179270133
-	// -5036824528102830934
+	// -5339971465336467958
+	// 5036824528102830934
+	// 4435185786993720788
 	// 8071137008395949086
-	// -2122761628320059770
-	// 6925466992496964832
-	// 5606570076237929230
+	// 2122761628320059770
+	// -5365688832259816617
+	// -300681375570251064
 	// -6485228379443441869
+	// -8468275846115330281
 	// -1089963290385541773
-	// -315038161257240872
-	// -8800248522230157011
 }
 
 // This example demonstrates usage of Int() generator with constraints for generation of int values.
@@ -74,16 +74,16 @@ func ExampleInt8() {
 		panic(err)
 	}
 	// Output:
-	// -31
+	// -16
 	// -91
-	// -40
-	// 50
-	// 79
+	// -81
+	// 40
+	// 104
+	// -21
+	// 122
 	// -40
 	// -116
-	// -44
-	// 102
-	// -23
+	// 67
 }
 
 // This example demonstrates usage of Int8() generator with constraints for generation of int8 values.
@@ -128,16 +128,16 @@ func ExampleInt16() {
 		panic(err)
 	}
 	// Output:
-	// 12790
-	// -13142
+	// -12790
+	// 13142
+	// 15828
 	// -5150
+	// 11651
 	// -3323
-	// 3378
+	// 16168
+	// -24597
 	// -4175
-	// 5523
-	// -5629
-	// 31913
-	// -20516
+	// 1247
 }
 
 // This example demonstrates usage of Int16() generator with constraints for generation of int16 values.
@@ -182,16 +182,16 @@ func ExampleInt32() {
 		panic(err)
 	}
 	// Output:
-	// 826517535
-	// 898515203
-	// 649801091
-	// -2023694845
+	// 1530763030
+	// -1726138304
+	// 446740315
+	// -1349338157
+	// 2023694845
 	// -141136839
-	// 1633988338
-	// 2006390345
-	// -16579444
-	// -2004448480
-	// -1121568663
+	// 189385913
+	// -1915228239
+	// -2125661407
+	// 986604357
 }
 
 // This example demonstrates usage of Int32() generator with constraints for generation of int32 values.
@@ -213,14 +213,14 @@ func ExampleInt32_constraints() {
 	// Output:
 	// 2
 	// 0
-	// 5
-	// -4
+	// -1
+	// 4
+	// 0
+	// -5
 	// -3
 	// 0
-	// 4
-	// 1
 	// 2
-	// 3
+	// 4
 }
 
 // This example demonstrates usage of Int64() generator for generation of int64 values.
@@ -236,16 +236,16 @@ func ExampleInt64() {
 		panic(err)
 	}
 	// Output:
-	// -4518808235179270133
-	// -5036824528102830934
+	// -5339971465336467958
+	// 5036824528102830934
+	// 4435185786993720788
 	// 8071137008395949086
-	// -2122761628320059770
-	// 6925466992496964832
-	// 5606570076237929230
+	// 2122761628320059770
+	// -5365688832259816617
+	// -300681375570251064
 	// -6485228379443441869
+	// -8468275846115330281
 	// -1089963290385541773
-	// -315038161257240872
-	// -8800248522230157011
 }
 
 // This example demonstrates usage of Int64() generator with constraints for generation of int64 values.
@@ -265,14 +265,14 @@ func ExampleInt64_constraints() {
 		panic(err)
 	}
 	// Output:
-	// -502
-	// 854
+	// -31
+	// -976
+	// -497
 	// 453
-	// 259
+	// 468
+	// -960
 	// 859
-	// 81
-	// 808
-	// -616
-	// 967
-	// -185
+	// 45
+	// 251
+	// -808
 }
@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"net"
+	"net/netip"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// prefixParts is an intermediate representation of netip.Prefix value.
+type prefixParts struct {
+	Addr netip.Addr
+	Bits uint8
+}
+
+// IPv4 returns generator for IPv4 addresses. Generator's target can be either net.IP or
+// netip.Addr type, and for net.IP target 4 byte representation is generated. Generated
+// addresses are shrunk towards 0.0.0.0. Error is returned if generator's target is neither
+// net.IP nor netip.Addr.
+func IPv4() arbitrary.Generator {
+	return ip("IPv4", reflect.TypeOf([4]byte{}), func(in reflect.Value) netip.Addr {
+		return netip.AddrFrom4(in.Interface().([4]byte))
+	})
+}
+
+// IPv6 returns generator for IPv6 addresses. Generator's target can be either net.IP or
+// netip.Addr type. Generated addresses are shrunk towards ::. Error is returned if
+// generator's target is neither net.IP nor netip.Addr.
+func IPv6() arbitrary.Generator {
+	return ip("IPv6", reflect.TypeOf([16]byte{}), func(in reflect.Value) netip.Addr {
+		return netip.AddrFrom16(in.Interface().([16]byte))
+	})
+}
+
+// IP returns generator for IPv4 and IPv6 addresses. Generator's target can be either net.IP
+// or netip.Addr type. Address is generated with either [IPv4] or [IPv6] generator, and IPv6
+// addresses are shrunk towards IPv4 addresses. Error is returned if generator's target is
+// neither net.IP nor netip.Addr.
+func IP() arbitrary.Generator {
+	return OneFrom(IPv4(), IPv6())
+}
+
+// ip returns generator of addresses whose bytes are generated as an array of "bytes"
+// type and are converted to address by "addr" parameter.
+func ip(name string, bytes reflect.Type, addr func(reflect.Value) netip.Addr) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		var mapFn func(reflect.Value) reflect.Value
+		switch target {
+		case reflect.TypeOf(netip.Addr{}):
+			mapFn = func(in reflect.Value) reflect.Value {
+				return reflect.ValueOf(addr(in))
+			}
+		case reflect.TypeOf(net.IP{}):
+			mapFn = func(in reflect.Value) reflect.Value {
+				return reflect.ValueOf(net.IP(addr(in).AsSlice()))
+			}
+		default:
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, name)
+		}
+
+		return Array(Uint8()).Map(arbitrary.Mapper(bytes, target, mapFn))(target, bias, r)
+	}
+}
+
+// Prefix returns generator for netip.Prefix type. Prefix's address is generated by either
+// [IPv4] or [IPv6] generator and prefix length is in range [0, address's bit length].
+// Generated prefixes are not masked, address bits after prefix length can be set (see
+// [netip.Prefix.Masked]). Prefixes are shrunk by shrinking their address and shrinking
+// prefix length towards 0, and IPv6 prefixes are shrunk towards IPv4 prefixes. Error is
+// returned if generator's target is not netip.Prefix.
+func Prefix() arbitrary.Generator {
+	return OneFrom(prefix(IPv4(), 32), prefix(IPv6(), 128))
+}
+
+// prefix returns generator of prefixes whose address is generated by "addr" generator
+// and whose length is in range [0, bits].
+func prefix(addr arbitrary.Generator, bits uint8) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf(netip.Prefix{}) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Prefix")
+		}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(prefixParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(prefixParts)
+			return reflect.ValueOf(netip.PrefixFrom(parts.Addr, int(parts.Bits)))
+		})
+
+		return Struct(map[string]arbitrary.Generator{
+			"Addr": addr,
+			"Bits": Uint8(constraints.Uint8{Min: 0, Max: bits}),
+		}).Map(mapper)(target, bias, r)
+	}
+}
@@ -0,0 +1,52 @@
+package generator_test
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use IP() generator for generation of net.IP and netip.Addr
+// values, that are either IPv4 or IPv6 addresses.
+func ExampleIP() {
+	streamer := generator.Streamer(
+		func(ip net.IP, addr netip.Addr) {
+			fmt.Println(ip, addr)
+		},
+		generator.IPv4(),
+		generator.IP(),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// 31.16.30.45 fb9f:be68:5c15:b9cc:4ff2:df49:a9e0:d143
+	// 30.64.102.205 ec5d:a28d:56ed:bd13:b9ed:d378:8b7d:4cad
+	// 224.162.232.10 74.27.0.170
+	// 7.231.176.4 115.113.171.0
+	// 145.137.145.215 f824:2619:af19:b81d:aa51:fa4c:162e:d980
+}
+
+// This example demonstrates how to use Prefix() generator for generation of netip.Prefix
+// values.
+func ExamplePrefix() {
+	streamer := generator.Streamer(
+		func(prefix netip.Prefix) {
+			fmt.Println(prefix, prefix.Masked())
+		},
+		generator.Prefix(),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// 1f10:1e2d:51fb:9fbe:685c:15b9:cc4f:f2df/40 1f10:1e2d:5100::/40
+	// 30.64.102.205/23 30.64.102.0/23
+	// 5da2:8d56:edbd:13b9:edd3:788b:7d4c:ad8c/10 5d80::/10
+	// 170.7.231.176/4 160.0.0.0/4
+	// 115.113.171.0/17 115.113.128.0/17
+}
@@ -0,0 +1,153 @@
+package generator
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+func TestIP(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			for _, generator := range []arbitrary.Generator{IPv4(), IPv6(), IP()} {
+				err := Stream(0, 10, Streamer(
+					func([]byte) {},
+					generator,
+				))
+
+				if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+					t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+				}
+			}
+		},
+		"IPv4": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(ip net.IP, addr netip.Addr) {
+					if len(ip) != net.IPv4len || !addr.Is4() {
+						t.Fatalf("Expected IPv4 addresses. Got: %s, %s", ip, addr)
+					}
+				},
+				IPv4(),
+				IPv4(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"IPv6": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(ip net.IP, addr netip.Addr) {
+					if len(ip) != net.IPv6len || !addr.Is6() {
+						t.Fatalf("Expected IPv6 addresses. Got: %s, %s", ip, addr)
+					}
+				},
+				IPv6(),
+				IPv6(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"IP": func(t *testing.T) {
+			v4, v6 := false, false
+			err := Stream(0, 100, Streamer(
+				func(addr netip.Addr) {
+					v4, v6 = v4 || addr.Is4(), v6 || addr.Is6()
+				},
+				IP(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !v4 || !v6 {
+				t.Fatalf("Expected both IPv4 and IPv6 addresses to be generated")
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, IPv4(), reflect.TypeOf(netip.Addr{}), func(v reflect.Value) bool {
+				return v.Interface().(netip.Addr).As4()[1] >= 10
+			})
+
+			if addr := shrunk.Interface().(netip.Addr); addr != netip.MustParseAddr("0.10.0.0") {
+				t.Fatalf("Expected address to be shrunk to: 0.10.0.0. Got: %s", addr)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(netip.Addr) {},
+				Prefix(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"ValidPrefixes": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(prefix netip.Prefix) {
+					if !prefix.IsValid() {
+						t.Fatalf("Invalid prefix: %s", prefix)
+					}
+				},
+				Prefix(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"LengthsOfIPv4Prefixes": func(t *testing.T) {
+			// Each IPv4 prefix length in range [0, 32] is generated.
+			lengths := map[int]int{}
+			err := Stream(0, 1000, Streamer(
+				func(prefix netip.Prefix) {
+					if prefix.Addr().Is4() {
+						if prefix.Bits() > 32 {
+							t.Fatalf("Invalid IPv4 prefix length: %d", prefix.Bits())
+						}
+						lengths[prefix.Bits()]++
+					}
+				},
+				Prefix(),
+			))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			for bits := 0; bits <= 32; bits++ {
+				if lengths[bits] == 0 {
+					t.Fatalf("IPv4 prefix with length %d is not generated", bits)
+				}
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, Prefix(), reflect.TypeOf(netip.Prefix{}), func(v reflect.Value) bool {
+				return v.Interface().(netip.Prefix).Bits() > 8
+			})
+
+			if prefix := shrunk.Interface().(netip.Prefix); prefix != netip.MustParsePrefix("0.0.0.0/9") {
+				t.Fatalf("Expected prefix to be shrunk to: 0.0.0.0/9. Got: %s", prefix)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
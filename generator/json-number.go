@@ -0,0 +1,25 @@
+package generator
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// JSONNumber returns generator for json.Number type. Generated numbers are valid JSON number
+// literals, with optional sign, fraction and exponent. Numbers are generated with
+// [StringMatching] generator and are shrunk towards 0. Error is returned if generator's target
+// is not json.Number.
+func JSONNumber() arbitrary.Generator {
+	number := StringMatching(`-?(0|[1-9][0-9]{0,8})(\.[0-9]{1,6})?([eE][+-]?[0-9]{1,3})?`)
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf(json.Number("")) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "JSONNumber")
+		}
+
+		return number(target, bias, r)
+	}
+}
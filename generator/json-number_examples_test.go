@@ -0,0 +1,29 @@
+package generator_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use JSONNumber() generator for generation of json.Number
+// values.
+func ExampleJSONNumber() {
+	streamer := generator.Streamer(
+		func(n json.Number) {
+			fmt.Println(n)
+		},
+		generator.JSONNumber(),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// -0e-8
+	// -0.928e80
+	// -83216e0
+	// 0
+	// 0.0191
+}
@@ -0,0 +1,53 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+func TestJSONNumber(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(string) {},
+				JSONNumber(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"ValidNumbers": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(n json.Number) {
+					var decoded json.Number
+					if err := json.Unmarshal([]byte(n), &decoded); err != nil || decoded != n {
+						t.Fatalf("Invalid JSON number: %s", n)
+					}
+				},
+				JSONNumber(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, JSONNumber(), reflect.TypeOf(json.Number("")), func(reflect.Value) bool {
+				return true
+			})
+
+			if n := shrunk.Interface().(json.Number); n != "0" {
+				t.Fatalf("Expected number to be shrunk to: 0. Got: %s", n)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -0,0 +1,30 @@
+package generator
+
+import (
+	"net/mail"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// MailAddress returns generator for mail.Address type. Generated addresses have an optional
+// name that consists of up to three capitalized words, and an address whose local part is a
+// sequence of alphanumeric words separated by one of "._+-" characters and whose domain has
+// one or two top level labels. Both are generated with [StringMatching] generator and are
+// shrunk accordingly, name towards empty one and address towards the shortest one. Error is
+// returned if generator's target is not mail.Address.
+func MailAddress() arbitrary.Generator {
+	parts := Struct(map[string]arbitrary.Generator{
+		"Name":    StringMatching(`([A-Z][a-z]{0,8}( [A-Z][a-z]{0,8}){0,2})?`),
+		"Address": StringMatching(`[a-z0-9]{1,10}([._+-][a-z0-9]{1,10}){0,2}@[a-z0-9]{1,10}(\.[a-z]{2,5}){1,2}`),
+	})
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf(mail.Address{}) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "MailAddress")
+		}
+
+		return parts(target, bias, r)
+	}
+}
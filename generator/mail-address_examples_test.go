@@ -0,0 +1,29 @@
+package generator_test
+
+import (
+	"fmt"
+	"net/mail"
+
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use MailAddress() generator for generation of mail.Address
+// values.
+func ExampleMailAddress() {
+	streamer := generator.Streamer(
+		func(address mail.Address) {
+			fmt.Println(address.String())
+		},
+		generator.MailAddress(),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// "Sqqwrfw" <0ur3-sl7cfv5ju@99wh3neu0d.cbnmw.ny>
+	// <pjb0cn98pc@y.qkal>
+	// <744ve_h@hgdnjupp3o.rmcw.ck>
+	// "Z Yurgl See" <5upu+i.fmag406@kb.ans>
+	// "Aqtzr Euvpqwaw" <mphjhugk+t19egbqcou@qg4y8r.mk.ukr>
+}
@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"errors"
+	"net/mail"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+func TestMailAddress(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(string) {},
+				MailAddress(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"RoundTrip": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(address mail.Address) {
+					parsed, err := mail.ParseAddress(address.String())
+					if err != nil {
+						t.Fatalf("Failed to parse address %s: %s", address.String(), err)
+					}
+					if *parsed != address {
+						t.Fatalf("Parsed address %v doesn't match generated address %v", parsed, address)
+					}
+				},
+				MailAddress(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, MailAddress(), reflect.TypeOf(mail.Address{}), func(reflect.Value) bool {
+				return true
+			})
+
+			if address := shrunk.Interface().(mail.Address); address.String() != "<0@0.aa>" {
+				t.Fatalf("Expected address to be shrunk to: <0@0.aa>. Got: %s", address.String())
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
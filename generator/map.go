@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"reflect"
@@ -14,14 +15,20 @@ import (
 // specified by "key" and "value" parameters, respectively. Range of map size values
 // is defined by "limits" parameter. If "limits" parameter is not specified default
 // [0, 100] range is used instead. Error is returned if generator's target is not a
-// map type, key generator returns an error, value generator returns an error or
-// limits.Min > limits.Max
+// map type, key generator returns an error, value generator returns an error,
+// limits.Min > limits.Max, or pool of keys gets exhausted before limits.Min keys are
+// generated. Generated maps are shrunk by removing subsets of keys, then by shrinking
+// only values and lastly by shrinking keys.
 //
 // Note: arbitrary.Generator will always try to create a map within size limits. This
 // means that during key generation it will take into account collision with
-// existing map key's. Pool of values from which keys are generated must have
-// number of unique values equal to map's maximum length value defined by
-// limits parameter, otherwise map generation will be stuck in endless loop.
+// existing map key's. Map's size is limited by the pool of values key generator can
+// generate (e.g. Int8(constraints.Int8{Min: 0, Max: 1}) or Bool() generate only 2 keys),
+// rather than by the number of values of map's key type. Size of the pool isn't known, thus
+// exhaustion is detected heuristically: pool is considered exhausted once unique key isn't
+// generated within 1000 consecutive attempts per each key generated so far, in which case map
+// is generated with the keys generated until then. Large pools with heavily biased key
+// generator may be considered exhausted before all of their keys are generated.
 func Map(keyGenerator, ValueGenerator arbitrary.Generator, limits ...constraints.Length) arbitrary.Generator {
 	constraint := constraints.LengthDefault()
 	if len(limits) != 0 {
@@ -38,25 +45,24 @@ func Map(keyGenerator, ValueGenerator arbitrary.Generator, limits ...constraints
 			return arbitrary.Arbitrary{}, fmt.Errorf("%w. max length %d can't be greater than %d", arbitrary.ErrorInvalidConstraints, constraint.Max, uint64(math.MaxInt64))
 		}
 
-		size := r.Uint64(constraints.Uint64{
-			Min: uint64(constraint.Min),
-			Max: uint64(constraint.Max),
-		})
+		size := r.Uint64(constraints.Uint64(constraint))
 
 		value := reflect.MakeMap(target)
 		elements := make(arbitrary.Arbitraries, size)
 
 		for index := 0; index < int(size); index++ {
-			var keyArb arbitrary.Arbitrary
-			var err error
-			for {
-				keyArb, err = keyGenerator(target.Key(), bias, r)
-				if err != nil {
-					return arbitrary.Arbitrary{}, fmt.Errorf("Failed to use map's Key generator. %w", err)
-				}
-				if !value.MapIndex(keyArb.Value).IsValid() {
-					break
-				}
+			keyArb, err := unique(keyGenerator, func(key reflect.Value) bool {
+				return value.MapIndex(key).IsValid()
+			}, index)(target.Key(), bias, r)
+			if errors.Is(err, errorExhausted) && uint64(index) >= constraint.Min {
+				elements = elements[:index]
+				break
+			}
+			switch {
+			case errors.Is(err, errorExhausted):
+				return arbitrary.Arbitrary{}, uniqueExhausted(err, index, constraint.Min)
+			case err != nil:
+				return arbitrary.Arbitrary{}, fmt.Errorf("Failed to use map's Key generator. %w", err)
 			}
 
 			valueArb, err := ValueGenerator(target.Elem(), bias, r)
@@ -66,7 +72,6 @@ func Map(keyGenerator, ValueGenerator arbitrary.Generator, limits ...constraints
 
 			elements[index] = arbitrary.Arbitrary{
 				Elements: arbitrary.Arbitraries{keyArb, valueArb},
-				Shrinker: shrinker.CollectionElements(elements[index]),
 			}
 
 			value.SetMapIndex(keyArb.Value, valueArb.Value)
@@ -82,3 +87,43 @@ func Map(keyGenerator, ValueGenerator arbitrary.Generator, limits ...constraints
 		return arb, nil
 	}
 }
+
+// MapOf returns generator for map types whose keys are elements of the slice specified by
+// "keys" parameter. Map's value for each key is generated with generator specified by
+// "value" parameter. Generated maps always contain all of the keys, and are shrunk by
+// shrinking only their values. Error is returned if generator's target is not a map type
+// with key of type K or value generator returns an error.
+func MapOf[K comparable](keys []K, value arbitrary.Generator) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target.Kind() != reflect.Map || target.Key() != reflect.TypeOf((*K)(nil)).Elem() {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "MapOf")
+		}
+
+		arb := arbitrary.Arbitrary{
+			Value: reflect.MakeMap(target),
+		}
+
+		for _, k := range keys {
+			key := reflect.ValueOf(&k).Elem()
+			if arb.Value.MapIndex(key).IsValid() {
+				continue
+			}
+
+			valueArb, err := value(target.Elem(), bias, r)
+			if err != nil {
+				return arbitrary.Arbitrary{}, fmt.Errorf("Failed to use map's Value generator. %w", err)
+			}
+
+			arb.Elements = append(arb.Elements, arbitrary.Arbitrary{
+				Elements: arbitrary.Arbitraries{{Value: key}, valueArb},
+			})
+			arb.Value.SetMapIndex(key, valueArb.Value)
+		}
+
+		arb.Shrinker = shrinker.MapEntries(1).
+			Validate(arbitrary.ValidateMap()).
+			TransformAfter(arbitrary.NewMap(target))
+
+		return arb, nil
+	}
+}
@@ -3,6 +3,7 @@ package generator
 import (
 	"errors"
 	"math"
+	"reflect"
 	"testing"
 
 	"github.com/steffnova/go-check/arbitrary"
@@ -41,6 +42,16 @@ func TestMap(t *testing.T) {
 				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
 			}
 		},
+		"ExhaustedKeyPool": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(map[int]int) {},
+				Map(Int(constraints.Int{Min: 0, Max: 5}), Int(), constraints.Length{Min: 7, Max: 7}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
 		"InvalidKeyTarget": func(t *testing.T) {
 			err := Stream(0, 10, Streamer(
 				func(map[uint]int) {},
@@ -76,6 +87,153 @@ func TestMap(t *testing.T) {
 				t.Fatalf("Unexpected error: '%s'", err)
 			}
 		},
+		"KeyPoolSizedToMap": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(in map[int]int) {
+					if len(in) != 1000 {
+						t.Fatalf("Expected map size 1000, got: %d", len(in))
+					}
+				},
+				Map(Int(constraints.Int{Min: 0, Max: 999}), Int(), constraints.Length{Min: 1000, Max: 1000}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: '%s'", err)
+			}
+		},
+		"KeyPoolSmallerThanMax": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(in map[int]int) {
+					if len(in) < 2 || len(in) > 6 {
+						t.Fatalf("Map size %d is not within [2, 6]", len(in))
+					}
+				},
+				Map(Int(constraints.Int{Min: 0, Max: 5}), Int(), constraints.Length{Min: 2, Max: 10}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: '%s'", err)
+			}
+		},
+		"KeySpaceExhausted": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(map[bool]int) {},
+				Map(Bool(), Int(), constraints.Length{Min: 3, Max: 10}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"KeyPoolSmallerThanKeyType": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(map[int8]int) {},
+				Map(Int8(constraints.Int8{Min: 0, Max: 1}), Int(), constraints.Length{Min: 3, Max: 10}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"KeySpaceLimitsSize": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(in map[[2]bool]int) {
+					if len(in) > 4 {
+						t.Fatalf("Map size %d is greater than number of keys 4", len(in))
+					}
+				},
+				Map(Array(Bool()), Int(), constraints.Length{Min: 2, Max: 10}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: '%s'", err)
+			}
+		},
+		"ShrinkValuesBeforeKeys": func(t *testing.T) {
+			failing := func(in reflect.Value) bool {
+				for _, key := range in.MapKeys() {
+					if key.Uint() >= 10 && in.MapIndex(key).Uint() >= 10 {
+						return true
+					}
+				}
+				return false
+			}
+
+			result := shrink(t, Map(Uint8(), Uint8()), reflect.TypeOf(map[uint8]uint8{}), failing)
+			if expected := (map[uint8]uint8{10: 10}); !reflect.DeepEqual(result.Interface(), expected) {
+				t.Fatalf("Expected shrunk map %v, got: %v", expected, result)
+			}
+		},
+		"ShrinkWithinConstraints": func(t *testing.T) {
+			constraint := constraints.Length{Min: 3, Max: 10}
+			failing := func(in reflect.Value) bool {
+				return in.Len() >= 3
+			}
+
+			result := shrink(t, Map(Uint8(), Uint8(), constraint), reflect.TypeOf(map[uint8]uint8{}), failing)
+			if expected := (map[uint8]uint8{0: 0, 1: 0, 2: 0}); !reflect.DeepEqual(result.Interface(), expected) {
+				t.Fatalf("Expected shrunk map %v, got: %v", expected, result)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
+
+func TestMapOf(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(map[uint]int) {},
+				MapOf([]int{1, 2, 3}, Int()),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidValueTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(map[int]uint) {},
+				MapOf([]int{1, 2, 3}, Int()),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"ContainsAllKeys": func(t *testing.T) {
+			keys := []string{"a", "b", "c", "b"}
+			err := Stream(0, 100, Streamer(
+				func(in map[string]int) {
+					if len(in) != 3 {
+						t.Fatalf("Expected map with 3 keys, got: %v", in)
+					}
+					for _, key := range keys {
+						if _, ok := in[key]; !ok {
+							t.Fatalf("Map %v doesn't contain key %q", in, key)
+						}
+					}
+				},
+				MapOf(keys, Int()),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: '%s'", err)
+			}
+		},
+		"ShrinkValues": func(t *testing.T) {
+			failing := func(in reflect.Value) bool {
+				return in.MapIndex(reflect.ValueOf("b")).Int() >= 10
+			}
+
+			result := shrink(t, MapOf([]string{"a", "b", "c"}, Int(constraints.Int{Min: 0, Max: 1000})), reflect.TypeOf(map[string]int{}), failing)
+			if expected := (map[string]int{"a": 0, "b": 10, "c": 0}); !reflect.DeepEqual(result.Interface(), expected) {
+				t.Fatalf("Expected shrunk map %v, got: %v", expected, result)
+			}
+		},
 	}
 
 	for name, testCase := range testCases {
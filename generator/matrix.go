@@ -0,0 +1,67 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
+)
+
+// Matrix returns generator for matrices of slice of slice types ([][]T), where all rows
+// have the same number of elements (columns). Matrix elements are generated with generator
+// specified by "element" parameter. Range of number of rows and columns is defined by "rows"
+// and "cols" parameters respectively. Matrix is shrunk by removing rows, then by removing
+// columns and finally by shrinking it's elements, so shrunk matrices always have rows of the
+// same length. Error is returned if generator's target is not a slice of slice type, element
+// generator returns an error, or rows or cols has Min greater than Max.
+func Matrix(element arbitrary.Generator, rows, cols constraints.Length) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target.Kind() != reflect.Slice || target.Elem().Kind() != reflect.Slice {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Matrix")
+		}
+		for _, limits := range []constraints.Length{rows, cols} {
+			switch {
+			case limits.Min > limits.Max:
+				return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal length value %d can't be greater than max length value %d", arbitrary.ErrorInvalidConstraints, limits.Min, limits.Max)
+			case limits.Max > uint64(math.MaxInt64):
+				return arbitrary.Arbitrary{}, fmt.Errorf("%w. Max length %d can't be greater than %d", arbitrary.ErrorInvalidConstraints, limits.Max, uint64(math.MaxInt64))
+			}
+		}
+
+		numRows := r.Uint64(constraints.Uint64(rows))
+		numCols := r.Uint64(constraints.Uint64(cols))
+
+		value := reflect.MakeSlice(target, int(numRows), int(numRows))
+		elements := make(arbitrary.Arbitraries, numRows)
+		for index := range elements {
+			row := reflect.MakeSlice(target.Elem(), int(numCols), int(numCols))
+			cells := make(arbitrary.Arbitraries, numCols)
+			for col := range cells {
+				cell, err := element(target.Elem().Elem(), bias, r)
+				if err != nil {
+					return arbitrary.Arbitrary{}, fmt.Errorf("failed to use matrix element generator. %w", err)
+				}
+				cells[col] = cell
+				row.Index(col).Set(cell.Value)
+			}
+
+			elements[index] = arbitrary.Arbitrary{
+				Value:    row,
+				Elements: cells,
+			}
+			elements[index].Shrinker = shrinker.CollectionElements(elements[index])
+			value.Index(index).Set(row)
+		}
+
+		arb := arbitrary.Arbitrary{
+			Value:    value,
+			Elements: elements,
+		}
+		arb.Shrinker = shrinker.Matrix(arb, rows, cols)
+
+		return arb, nil
+	}
+}
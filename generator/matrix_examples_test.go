@@ -0,0 +1,33 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Matrix() generator for generation of [][]int values
+// where all rows have the same number of columns.
+func ExampleMatrix() {
+	streamer := generator.Streamer(
+		func(matrix [][]int) {
+			fmt.Printf("%v\n", matrix)
+		},
+		generator.Matrix(
+			generator.Int(constraints.Int{Min: 0, Max: 9}),
+			constraints.Length{Min: 1, Max: 3},
+			constraints.Length{Min: 1, Max: 4},
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// [[6 0 0] [6 1 5]]
+	// [[0 3 1 8] [8 5 7 2] [9 2 8 5]]
+	// [[9 4] [9 9] [0 1]]
+	// [[4 9 8 0]]
+	// [[7 4] [3 2] [1 6]]
+}
@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestMatrix(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]int) {},
+				Matrix(Int(), constraints.LengthDefault(), constraints.LengthDefault()),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([][]int) {},
+				Matrix(Int(), constraints.LengthDefault(), constraints.Length{Min: 10, Max: 5}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"Shape": func(t *testing.T) {
+			rows, cols := constraints.Length{Min: 1, Max: 10}, constraints.Length{Min: 2, Max: 5}
+			err := Stream(0, 100, Streamer(
+				func(matrix [][]int) {
+					if len(matrix) < int(rows.Min) || len(matrix) > int(rows.Max) {
+						t.Fatalf("Number of rows %d is not within limits: %v", len(matrix), rows)
+					}
+					for _, row := range matrix {
+						if len(row) != len(matrix[0]) {
+							t.Fatalf("Rows have different lengths: %v", matrix)
+						}
+						if len(row) < int(cols.Min) || len(row) > int(cols.Max) {
+							t.Fatalf("Number of columns %d is not within limits: %v", len(row), cols)
+						}
+					}
+				},
+				Matrix(Int(), rows, cols),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"ShrinkToMinimalShape": func(t *testing.T) {
+			shrunk := shrink(t, Matrix(Int(constraints.Int{Min: 0, Max: 100}), constraints.Length{Min: 2, Max: 6}, constraints.Length{Min: 2, Max: 6}), reflect.TypeOf([][]int{}), func(reflect.Value) bool {
+				return true
+			})
+
+			if expected := [][]int{{0, 0}, {0, 0}}; !reflect.DeepEqual(shrunk.Interface(), expected) {
+				t.Fatalf("Expected matrix to be shrunk to: %v. Got: %v", expected, shrunk)
+			}
+		},
+		"ShrinkRowsAndColumns": func(t *testing.T) {
+			shrunk := shrink(t, Matrix(Int(constraints.Int{Min: 0, Max: 100}), constraints.Length{Min: 3, Max: 6}, constraints.Length{Min: 3, Max: 6}), reflect.TypeOf([][]int{}), func(v reflect.Value) bool {
+				matrix := v.Interface().([][]int)
+				for _, row := range matrix {
+					if len(row) != len(matrix[0]) {
+						t.Fatalf("Shrink has rows of different lengths: %v", matrix)
+					}
+					for _, n := range row {
+						if n >= 50 {
+							return true
+						}
+					}
+				}
+				return false
+			})
+
+			matrix := shrunk.Interface().([][]int)
+			sum := 0
+			for _, row := range matrix {
+				for _, n := range row {
+					sum += n
+				}
+			}
+			if len(matrix) != 3 || len(matrix[0]) != 3 || sum != 50 {
+				t.Fatalf("Expected 3x3 matrix with single 50 value. Got: %v", matrix)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
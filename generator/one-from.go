@@ -2,7 +2,9 @@ package generator
 
 import "github.com/steffnova/go-check/arbitrary"
 
-// OneFrom returns one of the provided generators. Error is returned if
+// OneFrom returns one of the provided generators, each chosen with equal probability.
+// Only the chosen generator is used for generating a value, and during shrinking
+// generators that precede it are tried first (see [Weighted]). Error is returned if
 // number of generators is 0, or chosen generator returns an error.
 func OneFrom(generator arbitrary.Generator, generators ...arbitrary.Generator) arbitrary.Generator {
 	generators = append([]arbitrary.Generator{generator}, generators...)
@@ -29,14 +29,14 @@ func ExampleOneFrom() {
 		panic(err)
 	}
 	// Output:
-	// 5
-	// 883
-	// 8
 	// -5
-	// -7
+	// -948
+	// -6
+	// 6
 	// 5
-	// 893
-	// -907
-	// 544
-	// -736
+	// 9
+	// 737
+	// 519
+	// 6
+	// -5
 }
@@ -26,14 +26,14 @@ func ExamplePtr() {
 		panic(err)
 	}
 	// Output:
-	// 3087144572626463248
-	// -322463145728654719
-	// 6634247955539956817
-	// -2122761628320059770
-	// 6925466992496964832
+	// -5036824528102830934
+	// -5254077479683016640
+	// -1543285579645681342
+	// 2122761628320059770
+	// -5174001876748624709
+	// -4132390935710051395
 	// <nil>
-	// 5606570076237929230
-	// 5259823212710600989
+	// -6485228379443441869
 	// -1089963290385541773
 	// <nil>
 }
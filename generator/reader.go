@@ -0,0 +1,117 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// readerParts is an intermediate representation of reader. Chunks define limits of sizes
+// of the first reads, where limit is MaxChunk - chunk, to shrink towards less limited reads.
+// Failure is the index of the read from which reader fails, and EOFWithData indicates
+// whether io.EOF is returned together with the last bytes.
+type readerParts struct {
+	Data        []byte
+	Chunks      []uint64
+	Failure     *uint64
+	EOFWithData bool
+}
+
+// reader is io.Reader created by [Reader] generator.
+type reader struct {
+	data        []byte
+	chunks      []uint64
+	failure     int
+	eofWithData bool
+	err         error
+	reads       int
+}
+
+// Read is implementation of io.Reader.Read
+func (r *reader) Read(p []byte) (int, error) {
+	defer func() { r.reads++ }()
+
+	switch {
+	case r.failure >= 0 && r.reads >= r.failure:
+		return 0, r.err
+	case len(p) == 0:
+		return 0, nil
+	case len(r.data) == 0:
+		return 0, io.EOF
+	}
+
+	size := len(p)
+	if r.reads < len(r.chunks) && r.chunks[r.reads] < uint64(size) {
+		size = int(r.chunks[r.reads])
+	}
+
+	n := copy(p[:size], r.data)
+	r.data = r.data[n:]
+	if len(r.data) == 0 && r.eofWithData {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Reader returns generator for io.Reader values, that can be used to test code that reads
+// and buffers data, similar to readers from testing/iotest package. Generated reader returns
+// data generated by [Bytes] generator, and reads it's data in chunks: each of the first reads
+// returns a random number of bytes in range [1, limits.MaxChunk], while the remaining reads are
+// not limited. Reader can also return io.EOF together with the last bytes, and if limits.Err
+// is not nil, fail with limits.Err from a random read onwards. The "limits" parameter, even
+// though it is variadic, evaluates only the first instance of [constraints.Reader]. If limits
+// are omitted, [constraints.ReaderDefault] is used instead. Generated readers are shrunk
+// towards readers that return shrunk data in a single read, without failing, while failing
+// readers are shrunk towards readers that fail on the first read. Error is returned
+// if generator's target is not an interface implemented by the reader (for example io.Reader),
+// limits.MaxChunk is 0, or Data or Chunks limits are invalid.
+func Reader(limits ...constraints.Reader) arbitrary.Generator {
+	constraint := constraints.ReaderDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		switch {
+		case target.Kind() != reflect.Interface || !reflect.TypeOf(&reader{}).Implements(target):
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Reader")
+		case constraint.MaxChunk == 0:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Max chunk size must be greater than 0", arbitrary.ErrorInvalidConstraints)
+		}
+
+		failure := Nil()
+		if constraint.Err != nil {
+			failure = Ptr(Uint64(constraints.Uint64{Min: 0, Max: constraint.Chunks.Max + 1}), constraints.Ptr{NilFrequency: 2})
+		}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(readerParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(readerParts)
+			chunks := make([]uint64, len(parts.Chunks))
+			for index, chunk := range parts.Chunks {
+				chunks[index] = constraint.MaxChunk - chunk
+			}
+
+			generated := &reader{
+				data:        parts.Data,
+				chunks:      chunks,
+				failure:     -1,
+				eofWithData: parts.EOFWithData,
+				err:         constraint.Err,
+			}
+			if parts.Failure != nil {
+				generated.failure = int(*parts.Failure)
+			}
+			return reflect.ValueOf(generated).Convert(target)
+		})
+
+		return Struct(map[string]arbitrary.Generator{
+			"Data":        Bytes(constraint.Data),
+			"Chunks":      Slice(Uint64(constraints.Uint64{Min: 0, Max: constraint.MaxChunk - 1}), constraint.Chunks),
+			"Failure":     failure,
+			"EOFWithData": Bool(),
+		}).Map(mapper)(target, bias, r)
+	}
+}
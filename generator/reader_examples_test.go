@@ -0,0 +1,69 @@
+package generator_test
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Reader() generator for generation of io.Reader values,
+// that return their data in chunks of random sizes and can fail with an error.
+func ExampleReader() {
+	streamer := generator.Streamer(
+		func(r io.Reader) {
+			buffer := make([]byte, 8)
+			for {
+				n, err := r.Read(buffer)
+				fmt.Printf("%x %v\n", buffer[:n], err)
+				if err != nil {
+					break
+				}
+			}
+			fmt.Println()
+		},
+		generator.Reader(constraints.Reader{
+			Data:     constraints.Length{Min: 5, Max: 15},
+			Chunks:   constraints.Length{Min: 0, Max: 3},
+			MaxChunk: 4,
+			Err:      io.ErrUnexpectedEOF,
+		}),
+	)
+
+	if err := generator.Stream(0, 8, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// f2bfeca7557ae90d <nil>
+	// 1fa8 EOF
+	//
+	// c0c7e266a13bea48 <nil>
+	// 283f88 <nil>
+	//  EOF
+	//
+	// c793 <nil>
+	// 690888cd <nil>
+	// b0cbb9cc EOF
+	//
+	// 8925f4 <nil>
+	// c5629e6f <nil>
+	// e043ac0a <nil>
+	// 64a933 EOF
+	//
+	// 3335848bfec9764b <nil>
+	// 7fa5a385 EOF
+	//
+	// 1340a165584cb534 <nil>
+	// 5921d45b94 <nil>
+	//  unexpected EOF
+	//
+	// 5076c72c <nil>
+	// 88 <nil>
+	// 3654a71b66f88b8c <nil>
+	// 68e0 <nil>
+	//  EOF
+	//
+	// 8988ebc9 <nil>
+	// e56e EOF
+}
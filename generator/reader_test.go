@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"testing/iotest"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestReader(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			for _, target := range []interface{}{func([]byte) {}, func(io.ReadCloser) {}} {
+				err := Stream(0, 10, Streamer(target, Reader()))
+
+				if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+					t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+				}
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(io.Reader) {},
+				Reader(constraints.Reader{Data: constraints.LengthDefault(), MaxChunk: 0}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"ChunkedReads": func(t *testing.T) {
+			limits := constraints.Reader{
+				Data:     constraints.Length{Min: 50, Max: 100},
+				Chunks:   constraints.Length{Min: 5, Max: 5},
+				MaxChunk: 4,
+			}
+
+			err := Stream(0, 100, Streamer(
+				func(r io.Reader) {
+					buffer := make([]byte, 10)
+					for read := 0; read < 5; read++ {
+						n, err := r.Read(buffer)
+						if n < 1 || n > 4 || err != nil {
+							t.Fatalf("Expected chunked read of [1, 4] bytes. Got: %d, %v", n, err)
+						}
+					}
+					if n, err := r.Read(buffer); n != len(buffer) || err != nil {
+						t.Fatalf("Expected unlimited read. Got: %d, %v", n, err)
+					}
+					if _, err := io.ReadAll(r); err != nil {
+						t.Fatalf("Unexpected error: %s", err)
+					}
+				},
+				Reader(limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Failures": func(t *testing.T) {
+			failures := 0
+			err := Stream(0, 100, Streamer(
+				func(r io.Reader) {
+					if _, err := io.ReadAll(r); err != nil {
+						if !errors.Is(err, iotest.ErrTimeout) {
+							t.Fatalf("Unexpected error: %s", err)
+						}
+						failures++
+					}
+				},
+				Reader(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if failures == 0 || failures == 100 {
+				t.Fatalf("Expected some of the readers to fail. Failed: %d", failures)
+			}
+		},
+		"NeverFails": func(t *testing.T) {
+			limits := constraints.ReaderDefault()
+			limits.Err = nil
+
+			err := Stream(0, 100, Streamer(
+				func(r io.Reader) {
+					if _, err := io.ReadAll(r); err != nil {
+						t.Fatalf("Unexpected error: %s", err)
+					}
+				},
+				Reader(limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			// Reader's data is consumed by the predicate, so the data of the last
+			// failing reader is recorded.
+			var last []byte
+			shrunk := shrink(t, Reader(), reflect.TypeOf((*io.Reader)(nil)).Elem(), func(v reflect.Value) bool {
+				data, _ := io.ReadAll(v.Interface().(io.Reader))
+				if len(data) > 3 {
+					last = data
+					return true
+				}
+				return false
+			})
+
+			generated := shrunk.Interface().(*reader)
+			if len(last) != 4 || len(generated.chunks) != 0 || generated.failure != -1 || generated.reads != 2 {
+				t.Fatalf("Expected reader to be shrunk to unchunked reader of 4 bytes. Got: %#v, data: %v", generated, last)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -1,17 +1,88 @@
 package generator
 
 import (
+	"reflect"
+
 	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
 )
 
-// Recurse is a type that is provided by the [Recursive] generator when
-// defining a generator with recursion.
+// Recurse is a type that is provided by the [Recursion] and [Recursive] generators
+// when defining a generator with recursion.
 type Recurse func() arbitrary.Generator
 
+// recursiveNode marks arbitraries generated by recursive calls. Only nodes with the same
+// key (e.g. grammar's rule) can replace one another during shrinking.
+type recursiveNode struct {
+	key string
+}
+
+// Recursion can be used to define recursive types (structures and functions). The "base" parameter
+// is a generator for the base case (e.g. a leaf or an empty list), while "recursive" parameter is a
+// function that provides a [Recurse] function, which can be used to specify a recursive call of the
+// generator. Every recursive call (including the root) generates either a base case or a recursive
+// case. At depth d (number of recursive cases above the call), recursive case is chosen with weight
+// limits.Frequency and base case with weight d+1, so that deeper calls are more likely to end the
+// recursion. Frequency is scaled by [constraints.Bias] (see [constraints.Recursive.Biased]), and
+// only base case is chosen once limits.Depth is reached. The "limits" parameter, even though it is
+// variadic, evaluates only the first instance of [constraints.Recursive]. If limits are omitted,
+// [constraints.RecursiveDefault] is used instead. Generated values are shrunk by replacing them
+// with one of their subtrees (values generated by nested recursive calls), by replacing recursive
+// case with the base case, and by shrinking the chosen case itself. Error is returned if base or
+// recursive generator returns an error.
+func Recursion(base arbitrary.Generator, recursive func(Recurse) arbitrary.Generator, limits ...constraints.Recursive) arbitrary.Generator {
+	constraint := constraints.RecursiveDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	var level func(depth uint) arbitrary.Generator
+	level = func(depth uint) arbitrary.Generator {
+		return recursion("", constraint, depth, base, func() arbitrary.Generator {
+			return recursive(func() arbitrary.Generator {
+				return level(depth + 1)
+			})
+		})
+	}
+
+	return level(0)
+}
+
+// recursion returns generator for a recursive call at "depth" that generates either a base case
+// with "base" generator, or a recursive case with generator returned by "recursive", the way it
+// is described in [Recursion]. Recursive case is not generated if "recursive" returns nil.
+// Generated values are marked as recursive nodes with the "key".
+func recursion(key string, constraint constraints.Recursive, depth uint, base arbitrary.Generator, recursive func() arbitrary.Generator) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		frequency := constraint.Biased(bias).Frequency
+
+		generator := base
+		if depth < constraint.Depth && frequency > 0 {
+			if recursiveCase := recursive(); recursiveCase != nil {
+				generator = Weighted(
+					[]uint64{uint64(depth) + 1, frequency},
+					base,
+					recursiveCase,
+				)
+			}
+		}
+
+		arb, err := generator(target, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, err
+		}
+		return recursiveArbitrary(arb, key), nil
+	}
+}
+
 // Recursive can be used to define recursive types (structures and functions). The 'genFunc' parameter
 // is a function that provides a [Recurse] function, which can be used to specify a recursive call of
 // the generator returned by 'genFunc'. The 'depth' parameter controls how deep the recursion goes
 // (a value of n will cause n recursions).
+//
+// Deprecated: Recursive always recurses to the full depth and ends the recursion with zero values,
+// use [Recursion] instead.
 func Recursive(genFunc func(Recurse) arbitrary.Generator, depth uint) arbitrary.Generator {
 	return genFunc(func() arbitrary.Generator {
 		if depth == 0 {
@@ -20,3 +91,47 @@ func Recursive(genFunc func(Recurse) arbitrary.Generator, depth uint) arbitrary.
 		return Recursive(genFunc, depth-1)
 	})
 }
+
+// recursiveArbitrary returns arbitrary that marks "arb" as a recursive node with the "key". It's
+// shrunk by replacing it with one of it's subtrees with the same key, and then by arb's shrinker.
+func recursiveArbitrary(arb arbitrary.Arbitrary, key string) arbitrary.Arbitrary {
+	isSubtree := func(arb arbitrary.Arbitrary) bool {
+		if len(arb.Precursors) != 2 || arb.Precursors[1].Value.Type() != reflect.TypeOf(recursiveNode{}) {
+			return false
+		}
+		return arb.Precursors[1].Value.Interface().(recursiveNode).key == key
+	}
+
+	node := recursiveMark(arb, key)
+	node.Shrinker = shrinker.Subtree(isSubtree, node.Shrinker)
+	return node
+}
+
+// recursiveMark returns arbitrary that marks "arb" as a recursive node with the "key", and
+// that's shrunk by arb's shrinker.
+func recursiveMark(arb arbitrary.Arbitrary, key string) arbitrary.Arbitrary {
+	node := arbitrary.Arbitrary{
+		Value: arb.Value,
+		Table: arb.Table,
+		Precursors: arbitrary.Arbitraries{
+			arb,
+			{Value: reflect.ValueOf(recursiveNode{key: key})},
+		},
+	}
+
+	if arb.Shrinker != nil {
+		node.Shrinker = func(node arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+			if node.Precursors[0].Shrinker == nil {
+				node.Shrinker = nil
+				return node, nil
+			}
+
+			shrink, err := node.Precursors[0].Shrinker(node.Precursors[0], propertyFailed)
+			if err != nil {
+				return arbitrary.Arbitrary{}, err
+			}
+			return recursiveMark(shrink, key), nil
+		}
+	}
+	return node
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/steffnova/go-check/constraints"
 )
 
-func ExampleRecursive_binaryTree() {
+func ExampleRecursion_binaryTree() {
 	type Node struct {
 		Value int
 		Left  *Node
@@ -26,27 +26,27 @@ func ExampleRecursive_binaryTree() {
 		func(tree *Node) {
 			fmt.Println(nodeString(tree))
 		},
-		Recursive(func(r Recurse) arbitrary.Generator {
+		Recursion(Nil(), func(r Recurse) arbitrary.Generator {
 			return Ptr(Struct(map[string]arbitrary.Generator{
 				"Value": Int(constraints.Int{Min: 0, Max: 10}),
 				"Left":  r(),
 				"Right": r(),
-			}), constraints.Ptr{NilFrequency: 3})
-		}, 3),
+			}), constraints.Ptr{NilFrequency: 0})
+		}, constraints.Recursive{Depth: 3, Frequency: 3}),
 	))
 
 	if err != nil {
 		panic(fmt.Errorf("Unexpected error: '%s'", err))
 	}
 	// Output:
-	// {Value: 2, Left: nil  Right {Value: 0, Left: nil  Right {Value: 5, Left: nil  Right {Value: 3, Left: nil  Right nil}}}}
-	// {Value: 3, Left: {Value: 1, Left: {Value: 8, Left: {Value: 5, Left: nil  Right nil}  Right nil}  Right {Value: 10, Left: {Value: 2, Left: nil  Right nil}  Right {Value: 5, Left: nil  Right nil}}}  Right nil}
-	// {Value: 9, Left: {Value: 4, Left: {Value: 9, Left: {Value: 1, Left: nil  Right nil}  Right {Value: 7, Left: nil  Right nil}}  Right {Value: 4, Left: {Value: 8, Left: nil  Right nil}  Right nil}}  Right nil}
-	// {Value: 6, Left: {Value: 9, Left: {Value: 7, Left: {Value: 4, Left: nil  Right nil}  Right {Value: 3, Left: nil  Right nil}}  Right nil}  Right {Value: 6, Left: {Value: 4, Left: {Value: 8, Left: nil  Right nil}  Right {Value: 9, Left: nil  Right nil}}  Right {Value: 3, Left: {Value: 0, Left: nil  Right nil}  Right {Value: 7, Left: nil  Right nil}}}}
-	// {Value: 9, Left: {Value: 9, Left: {Value: 8, Left: {Value: 2, Left: nil  Right nil}  Right {Value: 10, Left: nil  Right nil}}  Right {Value: 10, Left: {Value: 0, Left: nil  Right nil}  Right {Value: 10, Left: nil  Right nil}}}  Right {Value: 4, Left: {Value: 3, Left: nil  Right nil}  Right nil}}
+	// {Value: 6, Left: nil  Right nil}
+	// {Value: 3, Left: nil  Right {Value: 8, Left: nil  Right {Value: 5, Left: nil  Right nil}}}
+	// {Value: 8, Left: nil  Right nil}
+	// nil
+	// nil
 }
 
-func ExampleRecursive_recursiveFunction() {
+func ExampleRecursion_recursiveFunction() {
 	type recursive func() (int, recursive)
 
 	err := Stream(0, 10, Streamer(
@@ -59,28 +59,23 @@ func ExampleRecursive_recursiveFunction() {
 			}
 			fmt.Println(ns)
 		},
-		Recursive(func(r Recurse) arbitrary.Generator {
-			return Weighted(
-				[]uint64{4, 1},
-				Func(Int(constraints.Int{Min: 0, Max: 10}), r()),
-				Nil(),
-			)
-		}, 10),
+		Recursion(Nil(), func(r Recurse) arbitrary.Generator {
+			return Func(Int(constraints.Int{Min: 0, Max: 10}), r())
+		}, constraints.Recursive{Depth: 10, Frequency: 8}),
 	))
 
 	if err != nil {
 		panic(fmt.Errorf("Unexpected error: '%s'", err))
 	}
 	// Output:
-	// [4 2 7 5 9 8 8]
-	// [0]
-	// [10]
-	// [10 6]
-	// [1 8 9]
+	// [10 1]
+	// [8 5 1]
+	// [6 8 7 4]
+	// [2 3 7 2 0 8 3]
 	// []
-	// [10 8 8 3 9 6 10 4 3 9 2]
-	// [1 7]
+	// [6 6 0]
 	// []
 	// []
-
+	// [2 6 5 8 10 3]
+	// [8 4 10 3]
 }
@@ -2,12 +2,149 @@ package generator
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/steffnova/go-check/arbitrary"
 	"github.com/steffnova/go-check/constraints"
 )
 
+func TestRecursion(t *testing.T) {
+	type Node struct {
+		Value int
+		Left  *Node
+		Right *Node
+	}
+
+	height := (func(node *Node) uint)(nil)
+	height = func(node *Node) uint {
+		if node == nil {
+			return 0
+		}
+		return 1 + max(height(node.Left), height(node.Right))
+	}
+
+	tree := func(r Recurse) arbitrary.Generator {
+		return Ptr(Struct(map[string]arbitrary.Generator{
+			"Value": Int(constraints.Int{Min: 0, Max: 10}),
+			"Left":  r(),
+			"Right": r(),
+		}), constraints.Ptr{NilFrequency: 0})
+	}
+
+	testCase := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(int) {},
+				Recursion(Nil(), func(r Recurse) arbitrary.Generator {
+					return Struct(map[string]arbitrary.Generator{
+						"Value": Int(constraints.Int{Min: 0, Max: 10}),
+						"Left":  r(),
+						"Right": r(),
+					})
+				}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"Depth0": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(tree *Node) {
+					if tree != nil {
+						t.Fatalf("Only base case should be generated if depth is 0")
+					}
+				},
+				Recursion(Nil(), tree, constraints.Recursive{Depth: 0, Frequency: 3}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: '%s'", err)
+			}
+		},
+		"Depth5": func(t *testing.T) {
+			maxHeight := uint(0)
+			err := Stream(0, 100, Streamer(
+				func(tree *Node) {
+					maxHeight = max(maxHeight, height(tree))
+				},
+				Recursion(Nil(), tree, constraints.Recursive{Depth: 5, Frequency: 1000}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: '%s'", err)
+			}
+			if maxHeight != 5 {
+				t.Fatalf("Tree should have max height of 5, got %d", maxHeight)
+			}
+		},
+		"BaseCase": func(t *testing.T) {
+			leaf := Ptr(Struct(map[string]arbitrary.Generator{
+				"Value": Int(constraints.Int{Min: 0, Max: 10}),
+				"Left":  Nil(),
+				"Right": Nil(),
+			}), constraints.Ptr{NilFrequency: 0})
+
+			err := Stream(0, 100, Streamer(
+				func(tree *Node) {
+					if tree == nil {
+						t.Fatalf("Tree shouldn't be nil when base case is a leaf")
+					}
+				},
+				Recursion(leaf, tree),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: '%s'", err)
+			}
+		},
+		"Bias": func(t *testing.T) {
+			generator := Recursion(Nil(), tree)
+			target := reflect.TypeOf((*Node)(nil))
+
+			heights := map[bool]uint{}
+			for _, smallest := range []bool{true, false} {
+				bias := constraints.Bias{Size: 100, Scaling: 1}
+				if smallest {
+					bias.Scaling = 100
+				}
+
+				r := arbitrary.NewRandomNumber(0)
+				for i := 0; i < 100; i++ {
+					arb, err := generator(target, bias, r)
+					if err != nil {
+						t.Fatalf("Unexpected error: '%s'", err)
+					}
+					heights[smallest] += height(arb.Value.Interface().(*Node))
+				}
+			}
+
+			if heights[true] == 0 || heights[true] >= heights[false] {
+				t.Fatalf("Expected fewer, but still some recursive cases for the smallest bias. Total heights: %v", heights)
+			}
+		},
+		"ShrinkToSubtree": func(t *testing.T) {
+			var contains func(*Node) bool
+			contains = func(node *Node) bool {
+				return node != nil && (node.Value >= 5 || contains(node.Left) || contains(node.Right))
+			}
+
+			shrunk := shrink(t, Recursion(Nil(), tree), reflect.TypeOf((*Node)(nil)), func(v reflect.Value) bool {
+				return contains(v.Interface().(*Node))
+			})
+
+			if node := shrunk.Interface().(*Node); !reflect.DeepEqual(node, &Node{Value: 5}) {
+				t.Fatalf("Expected tree to be shrunk to a single node with value 5. Got: %#v", node)
+			}
+		},
+	}
+
+	for name, testCase := range testCase {
+		t.Run(name, testCase)
+	}
+}
+
 func TestRecursive(t *testing.T) {
 	type Node struct {
 		Value int
@@ -0,0 +1,236 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// regexRepeatLimit is the maximal number of repetitions, over the lower bound,
+// generated for unbounded repetition operators (*, + and {n,}).
+const regexRepeatLimit = 10
+
+// StringMatching returns generator for string types that generates strings matching regular
+// expression specified by "pattern" parameter. Pattern uses the same syntax as [regexp] package,
+// and generated strings match pattern as a whole (as if pattern is enclosed with ^(?:pattern)$).
+// Unbounded repetitions (*, + and {n,}) generate at most 10 repetitions over their lower bound.
+// Generated strings are shrunk using pattern's structure: repetitions are shrunk towards fewer
+// repetitions, alternations are shrunk towards earlier alternatives and character classes are
+// shrunk towards their first character. Error is returned if generator's target is not a string
+// type, pattern can't be parsed, pattern contains word boundaries (\b and \B) or can't match any
+// string, or pattern contains anchors (^, $, \A and \z) that are not at it's start or end.
+func StringMatching(pattern string) arbitrary.Generator {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return Invalid(fmt.Errorf("%w. Failed to parse pattern: %s. %s", arbitrary.ErrorInvalidConfig, pattern, err))
+	}
+
+	if err := regexAnchors(re, true, true); err != nil {
+		return Invalid(fmt.Errorf("%w. Pattern: %s. %s", arbitrary.ErrorInvalidConstraints, pattern, err))
+	}
+
+	generator, err := regex(re)
+	if err != nil {
+		return Invalid(fmt.Errorf("%w. Pattern: %s. %s", arbitrary.ErrorInvalidConfig, pattern, err))
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target.Kind() != reflect.String {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "StringMatching")
+		}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(""), target, func(in reflect.Value) reflect.Value {
+			return in.Convert(target)
+		})
+
+		return generator.Map(mapper)(target, bias, r)
+	}
+}
+
+// regex returns generator of strings that match regular expression syntax tree.
+func regex(re *syntax.Regexp) (arbitrary.Generator, error) {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return nil, fmt.Errorf("pattern %s can't match any string", re)
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText:
+		return Constant(""), nil
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase == 0 {
+			return Constant(string(re.Rune)), nil
+		}
+		generators := make([]arbitrary.Generator, len(re.Rune))
+		for index, r := range re.Rune {
+			variants := []arbitrary.Generator{Constant(string(r))}
+			for fold := unicode.SimpleFold(r); fold != r; fold = unicode.SimpleFold(fold) {
+				variants = append(variants, Constant(string(fold)))
+			}
+			generators[index] = OneFrom(variants[0], variants[1:]...)
+		}
+		return regexConcat(generators), nil
+	case syntax.OpCharClass:
+		return regexCharClass(re.Rune)
+	case syntax.OpAnyCharNotNL:
+		return regexCharClass([]rune{0, '\n' - 1, '\n' + 1, unicode.MaxRune})
+	case syntax.OpAnyChar:
+		return regexCharClass([]rune{0, unicode.MaxRune})
+	case syntax.OpCapture:
+		return regex(re.Sub[0])
+	case syntax.OpStar:
+		return regexRepeat(re.Sub[0], 0, -1)
+	case syntax.OpPlus:
+		return regexRepeat(re.Sub[0], 1, -1)
+	case syntax.OpQuest:
+		return regexRepeat(re.Sub[0], 0, 1)
+	case syntax.OpRepeat:
+		return regexRepeat(re.Sub[0], re.Min, re.Max)
+	case syntax.OpConcat, syntax.OpAlternate:
+		generators := make([]arbitrary.Generator, len(re.Sub))
+		for index, sub := range re.Sub {
+			generator, err := regex(sub)
+			if err != nil {
+				return nil, err
+			}
+			generators[index] = generator
+		}
+		if re.Op == syntax.OpAlternate {
+			return OneFrom(generators[0], generators[1:]...), nil
+		}
+		return regexConcat(generators), nil
+	default:
+		return nil, fmt.Errorf("unsupported regular expression operator: %s", re)
+	}
+}
+
+// regexAnchors returns an error if regular expression syntax tree contains anchors that are
+// not at the start or the end of the pattern, as generated strings can't match them (e.g. a^b).
+// The "start" and "end" parameters report whether tree is at the start or the end of the pattern.
+func regexAnchors(re *syntax.Regexp, start, end bool) error {
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpBeginText:
+		if !start {
+			return fmt.Errorf("anchor %s is not at the start of the pattern", re)
+		}
+	case syntax.OpEndLine, syntax.OpEndText:
+		if !end {
+			return fmt.Errorf("anchor %s is not at the end of the pattern", re)
+		}
+	case syntax.OpCapture, syntax.OpAlternate, syntax.OpQuest:
+		for _, sub := range re.Sub {
+			if err := regexAnchors(sub, start, end); err != nil {
+				return err
+			}
+		}
+	case syntax.OpStar, syntax.OpPlus, syntax.OpRepeat:
+		// Repeated anchor follows and precedes other repetitions.
+		return regexAnchors(re.Sub[0], start && re.Max == 1, end && re.Max == 1)
+	case syntax.OpConcat:
+		for index, sub := range re.Sub {
+			before, after := start, end
+			for _, other := range re.Sub[:index] {
+				before = before && regexEmpty(other)
+			}
+			for _, other := range re.Sub[index+1:] {
+				after = after && regexEmpty(other)
+			}
+			if err := regexAnchors(sub, before, after); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// regexEmpty returns true if regular expression syntax tree matches only empty strings.
+func regexEmpty(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText:
+		return true
+	case syntax.OpCapture, syntax.OpConcat, syntax.OpAlternate, syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		for _, sub := range re.Sub {
+			if !regexEmpty(sub) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// regexConcat returns generator that concatenates strings generated by generators.
+func regexConcat(generators []arbitrary.Generator) arbitrary.Generator {
+	mapper := arbitrary.Mapper(reflect.ArrayOf(len(generators), reflect.TypeOf("")), reflect.TypeOf(""), func(in reflect.Value) reflect.Value {
+		builder := strings.Builder{}
+		for index := 0; index < in.Len(); index++ {
+			builder.WriteString(in.Index(index).String())
+		}
+		return reflect.ValueOf(builder.String())
+	})
+
+	return ArrayFrom(generators...).Map(mapper)
+}
+
+// regexRepeat returns generator that concatenates between min and max strings matching
+// regular expression. Max value -1 defines unbounded repetition.
+func regexRepeat(re *syntax.Regexp, min, max int) (arbitrary.Generator, error) {
+	if max == -1 {
+		max = min + regexRepeatLimit
+	}
+
+	generator, err := regex(re)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := arbitrary.Mapper(reflect.TypeOf([]string{}), reflect.TypeOf(""), func(in reflect.Value) reflect.Value {
+		return reflect.ValueOf(strings.Join(in.Interface().([]string), ""))
+	})
+
+	return Slice(generator, constraints.Length{Min: uint64(min), Max: uint64(max)}).Map(mapper), nil
+}
+
+// regexCharClass returns generator of single character strings whose characters belong to
+// character class defined by pairs of inclusive rune ranges. Surrogate code points are
+// excluded as they can't be represented in a string.
+func regexCharClass(class []rune) (arbitrary.Generator, error) {
+	ranges := []rune{}
+	total := uint64(0)
+	for index := 0; index+1 < len(class); index += 2 {
+		lo, hi := class[index], class[index+1]
+		below, above := hi, lo
+		if below > 0xd7ff {
+			below = 0xd7ff
+		}
+		if above < 0xe000 {
+			above = 0xe000
+		}
+		for _, r := range [][2]rune{{lo, below}, {above, hi}} {
+			if r[0] <= r[1] {
+				ranges = append(ranges, r[0], r[1])
+				total += uint64(r[1]-r[0]) + 1
+			}
+		}
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("character class %v is empty", class)
+	}
+
+	mapper := arbitrary.Mapper(reflect.TypeOf(uint64(0)), reflect.TypeOf(""), func(in reflect.Value) reflect.Value {
+		n := in.Uint()
+		for index := 0; index+1 < len(ranges); index += 2 {
+			size := uint64(ranges[index+1]-ranges[index]) + 1
+			if n < size {
+				return reflect.ValueOf(string(ranges[index] + rune(n)))
+			}
+			n -= size
+		}
+		return reflect.ValueOf(string(ranges[len(ranges)-1]))
+	})
+
+	return Uint64(constraints.Uint64{Min: 0, Max: total - 1}).Map(mapper), nil
+}
@@ -0,0 +1,33 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use StringMatching() generator for generation of strings
+// that match regular expression. Generated strings match the whole pattern.
+func ExampleStringMatching() {
+	streamer := generator.Streamer(
+		func(s string) {
+			fmt.Println(s)
+		},
+		generator.StringMatching(`[a-z]{2,6}(\.[a-z]{2,6})?@(example|test)\.(com|org)`),
+	)
+
+	if err := generator.Stream(0, 10, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// wqqw.dadnri@example.org
+	// psif.juj@test.com
+	// mxp@test.com
+	// inytmz.ylamx@test.com
+	// imkq@example.com
+	// hhqe@test.org
+	// pzy@test.com
+	// wgac.mah@example.com
+	// rglsls@example.com
+	// ffirz@example.org
+}
@@ -0,0 +1,152 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+func TestStringMatching(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(int) {},
+				StringMatching("[a-z]+"),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidPattern": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(string) {},
+				StringMatching("[a-z"),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConfig)
+			}
+		},
+		"WordBoundary": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(string) {},
+				StringMatching(`\bword\b`),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConfig)
+			}
+		},
+		"AnchorWithinPattern": func(t *testing.T) {
+			for _, pattern := range []string{`a^b`, `a$b`, `c(a|^b)`, `a\Ab`, `(a$)+b`, `(^a)*`} {
+				err := Stream(0, 10, Streamer(
+					func(string) {},
+					StringMatching(pattern),
+				))
+
+				if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+					t.Fatalf("Expected error: '%s' for pattern %s", arbitrary.ErrorInvalidConstraints, pattern)
+				}
+			}
+		},
+		"NoMatch": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(string) {},
+				StringMatching(`[^\x00-\x{10FFFF}]`),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConfig)
+			}
+		},
+		"Matches": func(t *testing.T) {
+			patterns := []string{
+				`[a-z]{3,8}`,
+				`(foo|bar)+baz?`,
+				`(?i)hello`,
+				`\d{3}-\d{4}`,
+				`^[A-Z][a-z]*$`,
+				`[^a-z]`,
+				`.*`,
+				`(?s).{2}`,
+				`[[:alpha:]_][[:word:]]*`,
+				`\p{Greek}+`,
+				`a{2,}`,
+				`x?y*z+`,
+				`(a|)b`,
+				`^(a|b)$`,
+				`(^a|^b)c$`,
+				`(?m)^a$`,
+				`\Aa?\z`,
+			}
+
+			for _, pattern := range patterns {
+				re := regexp.MustCompile("^(?:" + pattern + ")$")
+				err := Stream(0, 100, Streamer(
+					func(s string) {
+						if !re.MatchString(s) {
+							t.Fatalf("String %q doesn't match pattern: %s", s, pattern)
+						}
+					},
+					StringMatching(pattern),
+				))
+
+				if err != nil {
+					t.Fatalf("Unexpected error for pattern %s: %s", pattern, err)
+				}
+			}
+		},
+		"StringType": func(t *testing.T) {
+			type Code string
+			re := regexp.MustCompile("^[A-Z]{2}[0-9]{2}$")
+			err := Stream(0, 10, Streamer(
+				func(code Code) {
+					if !re.MatchString(string(code)) {
+						t.Fatalf("Code %q doesn't match pattern", code)
+					}
+				},
+				StringMatching("[A-Z]{2}[0-9]{2}"),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"ShrinkRepetition": func(t *testing.T) {
+			shrunk := shrink(t, StringMatching("[a-z]{3,8}"), reflect.TypeOf(""), func(reflect.Value) bool {
+				return true
+			})
+
+			if shrunk.String() != "aaa" {
+				t.Fatalf("Expected string to be shrunk to: aaa. Got: %s", shrunk)
+			}
+		},
+		"ShrinkAlternation": func(t *testing.T) {
+			shrunk := shrink(t, StringMatching("(x+|y+|z+)"), reflect.TypeOf(""), func(reflect.Value) bool {
+				return true
+			})
+
+			if shrunk.String() != "x" {
+				t.Fatalf("Expected string to be shrunk to: x. Got: %s", shrunk)
+			}
+		},
+		"ShrinkWithinAlternative": func(t *testing.T) {
+			re := regexp.MustCompile("[0-9]{2}")
+			shrunk := shrink(t, StringMatching("([a-z]{1,4}|[0-9]{2,4})"), reflect.TypeOf(""), func(v reflect.Value) bool {
+				return re.MatchString(v.String())
+			})
+
+			if shrunk.String() != "00" {
+				t.Fatalf("Expected string to be shrunk to: 00. Got: %s", shrunk)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -0,0 +1,47 @@
+package generator
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+var registry = struct {
+	sync.RWMutex
+	generators map[reflect.Type]arbitrary.Generator
+}{
+	generators: map[reflect.Type]arbitrary.Generator{},
+}
+
+// Register registers generator for a type specified by "target" parameter. Registered
+// generator is used by [Any] (and generators that depend on it like [Struct], or [Slice],
+// [Map], [Ptr] and [Array] when they are used with [Any]) every time it needs to generate a
+// value of the target type, instead of choosing generator based on target's kind. This
+// allows named types, whose meaning can't be inferred from their kind, to be generated
+// with meaningful values:
+//
+//	generator.Register(reflect.TypeOf(Money{}), moneyGenerator)
+//
+// Registering a generator for a type that already has one replaces it, while passing nil
+// generator removes the registration.
+func Register(target reflect.Type, generator arbitrary.Generator) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if generator == nil {
+		delete(registry.generators, target)
+		return
+	}
+	registry.generators[target] = generator
+}
+
+// registered returns generator registered for target type and bool value that
+// indicates whether the generator is registered.
+func registered(target reflect.Type) (arbitrary.Generator, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	generator, exists := registry.generators[target]
+	return generator, exists
+}
@@ -0,0 +1,99 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestRegister(t *testing.T) {
+	type Money int64
+	type Account struct {
+		Balance Money
+	}
+
+	Register(reflect.TypeOf(Money(0)), Int64(constraints.Int64{Min: 0, Max: 100}))
+	defer Register(reflect.TypeOf(Money(0)), nil)
+
+	valid := func(m Money) bool {
+		return m >= 0 && m <= 100
+	}
+
+	testCases := map[string]func(*testing.T){
+		"Any": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(m Money) {
+					if !valid(m) {
+						t.Fatalf("Registered generator is not used for: %d", m)
+					}
+				},
+				Any(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Struct": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(a Account) {
+					if !valid(a.Balance) {
+						t.Fatalf("Registered generator is not used for struct field: %d", a.Balance)
+					}
+				},
+				Struct(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Slice": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(ms []Money) {
+					for _, m := range ms {
+						if !valid(m) {
+							t.Fatalf("Registered generator is not used for slice element: %d", m)
+						}
+					}
+				},
+				Slice(Any()),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Map": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(ms map[Money]Money) {
+					for key, value := range ms {
+						if !valid(key) || !valid(value) {
+							t.Fatalf("Registered generator is not used for map key-value: %d-%d", key, value)
+						}
+					}
+				},
+				Map(Any(), Any(), constraints.Length{Min: 0, Max: 10}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Unregister": func(t *testing.T) {
+			type Unregistered uint8
+
+			Register(reflect.TypeOf(Unregistered(0)), Constant(Unregistered(1)))
+			Register(reflect.TypeOf(Unregistered(0)), nil)
+
+			if _, exists := registered(reflect.TypeOf(Unregistered(0))); exists {
+				t.Fatalf("Generator should not be registered")
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
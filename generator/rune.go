@@ -3,40 +3,173 @@ package generator
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"unicode"
 
 	"github.com/steffnova/go-check/arbitrary"
 	"github.com/steffnova/go-check/constraints"
 )
 
+// assigned are the tables of all assigned code points, excluding surrogates.
+var assigned = []*unicode.RangeTable{
+	unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Z,
+	unicode.Cc, unicode.Cf, unicode.Co,
+}
+
+// runeSimplicity are the ranges of code points ordered from the simplest ones: lowercase
+// ASCII letters, digits, space and the rest of ASCII. Code points outside of these ranges
+// are the least simple ones.
+var runeSimplicity = [][]rune{
+	{'a', 'z'},
+	{'0', '9'},
+	{' ', ' '},
+	{0, unicode.MaxASCII},
+}
+
 // Rune returns generator for rune types. Range of rune values that can be
 // generated is defined by "limits" parameter. If no limits are provided default
 // [0, 0x10ffff] code point range is used which includes all Unicode16 characters.
-// Error is returned if minimal code point is greater than maximal code point,
-// minimal code point is lower than 0 or maximal code point is greater than 0x10ffff
+// Code points can be further limited to unicode categories or scripts with
+// limits.Tables, some of them can be excluded with limits.Exclude, while
+// limits.Assigned excludes unassigned code points and surrogates. Generated
+// runes are shrunk towards simpler ones: 'a', then other lowercase letters,
+// digits, space, the rest of ASCII and finally the rest of code points. Error is
+// returned if minimal code point is greater than maximal code point, minimal
+// code point is lower than 0, maximal code point is greater than 0x10ffff, or
+// no code point satisfies the limits.
 func Rune(limits ...constraints.Rune) arbitrary.Generator {
 	constraint := constraints.RuneDefault()
 	if len(limits) != 0 {
 		constraint = limits[0]
 	}
 
+	switch {
+	case constraint.MinCodePoint > constraint.MaxCodePoint:
+		return Invalid(fmt.Errorf("%w. Minimal code point %d can't be greater than maximal code point: %d", arbitrary.ErrorInvalidConstraints, constraint.MinCodePoint, constraint.MaxCodePoint))
+	case constraint.MinCodePoint < 0:
+		return Invalid(fmt.Errorf("%w. Minimal code point must be greater then or equal to 0", arbitrary.ErrorInvalidConstraints))
+	case constraint.MaxCodePoint > 0x10ffff:
+		return Invalid(fmt.Errorf("%w. Maximal code point must be lower then or equal to 0x10ffff", arbitrary.ErrorInvalidConstraints))
+	}
+
+	ranges := []rune{constraint.MinCodePoint, constraint.MaxCodePoint}
+	if len(constraint.Tables) != 0 {
+		ranges = runeRangesIntersect(ranges, runeRangesFrom(constraint.Tables...))
+	}
+	if constraint.Assigned {
+		ranges = runeRangesIntersect(ranges, runeRangesFrom(assigned...))
+	}
+	ranges = runeRangesSubtract(ranges, runeRangesFrom(constraint.Exclude...))
+
+	// Code points are ordered by their simplicity, and runes are shrunk towards
+	// the simplest ones.
+	ordered := []rune{}
+	for _, simplest := range runeSimplicity {
+		ordered = append(ordered, runeRangesIntersect(ranges, simplest)...)
+		ranges = runeRangesSubtract(ranges, simplest)
+	}
+	ranges = append(ordered, ranges...)
+
+	// Offsets hold the number of code points that precede each of the ranges, which
+	// allows finding the range of n-th code point with binary search.
+	total, offsets := uint64(0), make([]uint64, len(ranges)/2)
+	for index := 0; index < len(ranges); index += 2 {
+		offsets[index/2] = total
+		total += uint64(ranges[index+1]-ranges[index]) + 1
+	}
+	if total == 0 {
+		return Invalid(fmt.Errorf("%w. There are no code points that satisfy the constraints", arbitrary.ErrorInvalidConstraints))
+	}
+
 	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
-		switch {
-		case target.Kind() != reflect.Int32:
+		if target.Kind() != reflect.Int32 {
 			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Rune")
-		case constraint.MinCodePoint > constraint.MaxCodePoint:
-			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal code point %d can't be greater than maximal code point: %d", arbitrary.ErrorInvalidConstraints, constraint.MinCodePoint, constraint.MaxCodePoint)
-		case constraint.MinCodePoint < 0:
-			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal code point must be greater then or equal to 0", arbitrary.ErrorInvalidConstraints)
-		case constraint.MaxCodePoint > 0x10ffff:
-			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Maximal code point must be lower then or equal to 0x10ffff", arbitrary.ErrorInvalidConstraints)
-		default:
-			mapper := arbitrary.Mapper(reflect.TypeOf(int32(0)), target, func(in reflect.Value) reflect.Value {
-				return reflect.ValueOf(rune(int32(in.Int()))).Convert(target)
-			})
-			return Int32(constraints.Int32{
-				Max: constraint.MaxCodePoint,
-				Min: constraint.MinCodePoint,
-			}).Map(mapper)(target, bias, r)
+		}
+
+		convertFn := func(in reflect.Value) reflect.Value {
+			n := in.Uint()
+			index := sort.Search(len(offsets), func(index int) bool {
+				return offsets[index] > n
+			}) - 1
+			return reflect.ValueOf(ranges[2*index] + rune(n-offsets[index])).Convert(target)
+		}
+
+		return convert(Uint64(constraints.Uint64{Min: 0, Max: total - 1}), reflect.TypeOf(uint64(0)), convertFn)(target, bias, r)
+	}
+}
+
+// runeRangesFrom returns sorted, non-overlapping code point ranges, in form of
+// [lo, hi] pairs, that include all code points of the "tables".
+func runeRangesFrom(tables ...*unicode.RangeTable) []rune {
+	ranges := [][2]rune{}
+	add := func(lo, hi, stride rune) {
+		if stride == 1 {
+			ranges = append(ranges, [2]rune{lo, hi})
+			return
+		}
+		for r := lo; r <= hi; r += stride {
+			ranges = append(ranges, [2]rune{r, r})
+		}
+	}
+	for _, table := range tables {
+		for _, r := range table.R16 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+		for _, r := range table.R32 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i][0] < ranges[j][0]
+	})
+
+	merged := []rune{}
+	for _, r := range ranges {
+		if last := len(merged) - 1; last > 0 && r[0] <= merged[last]+1 {
+			merged[last] = max(merged[last], r[1])
+			continue
+		}
+		merged = append(merged, r[0], r[1])
+	}
+	return merged
+}
+
+// runeRangesIntersect returns code point ranges that are in both "a" and "b" ranges.
+func runeRangesIntersect(a, b []rune) []rune {
+	intersection := []rune{}
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		if lo, hi := max(a[i], b[j]), min(a[i+1], b[j+1]); lo <= hi {
+			intersection = append(intersection, lo, hi)
+		}
+		if a[i+1] < b[j+1] {
+			i += 2
+		} else {
+			j += 2
+		}
+	}
+	return intersection
+}
+
+// runeRangesSubtract returns code point ranges that are in "a" ranges, but not in "b" ranges.
+func runeRangesSubtract(a, b []rune) []rune {
+	difference := []rune{}
+	for i, j := 0, 0; i < len(a); i += 2 {
+		lo := a[i]
+		for ; j < len(b) && b[j] <= a[i+1]; j += 2 {
+			if b[j+1] < lo {
+				continue
+			}
+			if b[j] > lo {
+				difference = append(difference, lo, b[j]-1)
+			}
+			lo = b[j+1] + 1
+			if b[j+1] > a[i+1] {
+				break
+			}
+		}
+		if lo <= a[i+1] {
+			difference = append(difference, lo, a[i+1])
 		}
 	}
+	return difference
 }
@@ -2,6 +2,7 @@ package generator_test
 
 import (
 	"fmt"
+	"unicode"
 
 	"github.com/steffnova/go-check/constraints"
 	"github.com/steffnova/go-check/generator"
@@ -60,3 +61,35 @@ func ExampleRune_constraints() {
 	// w
 	// u
 }
+
+// This example demonstrates how to use Rune() generator with unicode tables for generation
+// of rune values from Greek script, excluding uppercase letters and marks.
+func ExampleRune_tables() {
+	streamer := generator.Streamer(
+		func(r rune) {
+			fmt.Printf("%c\n", r)
+		},
+		generator.Rune(constraints.Rune{
+			MinCodePoint: 0,
+			MaxCodePoint: unicode.MaxRune,
+			Tables:       []*unicode.RangeTable{unicode.Greek},
+			Exclude:      []*unicode.RangeTable{unicode.Lu, unicode.M},
+			Assigned:     true,
+		}),
+	)
+
+	if err := generator.Stream(0, 10, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// ρ
+	// β
+	// 𝈑
+	// 𝈺
+	// 𐅡
+	// 𐅎
+	// π
+	// 𝈖
+	// 𝈾
+	// ϐ
+}
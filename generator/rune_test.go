@@ -3,7 +3,10 @@ package generator
 import (
 	"errors"
 	"math"
+	"reflect"
+	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/steffnova/go-check/arbitrary"
 	"github.com/steffnova/go-check/constraints"
@@ -67,6 +70,88 @@ func TestRune(t *testing.T) {
 				t.Fatalf("Unexpected error: %s", err)
 			}
 		},
+		"EmptyConstraints": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(rune) {},
+				Rune(constraints.Rune{MinCodePoint: 0, MaxCodePoint: 127, Tables: []*unicode.RangeTable{unicode.Han}}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"Tables": func(t *testing.T) {
+			constraint := constraints.RuneDefault()
+			constraint.Tables = []*unicode.RangeTable{unicode.Greek, unicode.Nd}
+			constraint.Exclude = []*unicode.RangeTable{unicode.Lu}
+
+			err := Stream(0, 1000, Streamer(
+				func(c rune) {
+					if !unicode.In(c, constraint.Tables...) || unicode.IsUpper(c) {
+						t.Fatalf("Rune %U is not within constraints", c)
+					}
+				},
+				Rune(constraint),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Assigned": func(t *testing.T) {
+			constraint := constraints.RuneDefault()
+			constraint.Assigned = true
+
+			err := Stream(0, 1000, Streamer(
+				func(c rune) {
+					if unicode.Is(unicode.Cs, c) || !unicode.In(c, assigned...) {
+						t.Fatalf("Rune %U is not assigned", c)
+					}
+				},
+				Rune(constraint),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, Rune(), reflect.TypeOf(rune(0)), func(v reflect.Value) bool {
+				return true
+			})
+
+			if c := rune(shrunk.Int()); c != 'a' {
+				t.Fatalf("Expected rune to be shrunk to 'a'. Got: %q", c)
+			}
+		},
+		"ShrinkWithinTables": func(t *testing.T) {
+			constraint := constraints.RuneDefault()
+			constraint.Tables = []*unicode.RangeTable{unicode.Nd}
+
+			shrunk := shrink(t, Rune(constraint), reflect.TypeOf(rune(0)), func(v reflect.Value) bool {
+				return true
+			})
+
+			if c := rune(shrunk.Int()); c != '0' {
+				t.Fatalf("Expected rune to be shrunk to '0'. Got: %q", c)
+			}
+		},
+		"ShrinkBySimplicity": func(t *testing.T) {
+			for simpler, expected := range map[string]rune{
+				"a":                                     'b',
+				"abcdefghijklmnopqrstuvwxyz":            '0',
+				"abcdefghijklmnopqrstuvwxyz0123456789":  ' ',
+				"abcdefghijklmnopqrstuvwxyz0123456789 ": 0,
+			} {
+				shrunk := shrink(t, Rune(), reflect.TypeOf(rune(0)), func(v reflect.Value) bool {
+					return !strings.ContainsRune(simpler, rune(v.Int()))
+				})
+
+				if c := rune(shrunk.Int()); c != expected {
+					t.Fatalf("Expected rune to be shrunk to %q. Got: %q", expected, c)
+				}
+			}
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,86 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"unsafe"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
+)
+
+// SameLength returns generator for struct types whose fields are all slices of the same length
+// (for example columns of a table). Generator for elements of each slice is specified positionally
+// by "elements" parameter: first generator is used for elements of the first field's slice, second
+// for the second field and so on. Range of slice length values is defined by "limits" parameter.
+// Slices are shrunk together: elements with the same index are removed from all slices at once,
+// followed by shrinking of individual elements, so all slices always have the same length. Error
+// is returned if generator's target is not a struct whose fields are slices, number of element
+// generators doesn't match the number of struct's fields, limits.Min > limits.Max, or any of the
+// element generators returns an error.
+func SameLength(limits constraints.Length, elements ...arbitrary.Generator) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target.Kind() != reflect.Struct || target.NumField() == 0 {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "SameLength")
+		}
+		for index := 0; index < target.NumField(); index++ {
+			if target.Field(index).Type.Kind() != reflect.Slice {
+				return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "SameLength")
+			}
+		}
+		switch {
+		case target.NumField() != len(elements):
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidCollectionSize(target.NumField(), len(elements))
+		case limits.Min > limits.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal length value %d can't be greater than max length value %d", arbitrary.ErrorInvalidConstraints, limits.Min, limits.Max)
+		case limits.Max > uint64(math.MaxInt64):
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Max length %d can't be greater than %d", arbitrary.ErrorInvalidConstraints, limits.Max, uint64(math.MaxInt64))
+		}
+
+		// Each row holds elements with the same index of all slices.
+		rows := make(arbitrary.Arbitraries, r.Uint64(constraints.Uint64(limits)))
+		for index := range rows {
+			cells := make(arbitrary.Arbitraries, len(elements))
+			for field, element := range elements {
+				cell, err := element(target.Field(field).Type.Elem(), bias, r)
+				if err != nil {
+					return arbitrary.Arbitrary{}, fmt.Errorf("failed to use element generator for field: %s. %w", target.Field(field).Name, err)
+				}
+				cells[field] = cell
+			}
+			rows[index] = arbitrary.Arbitrary{Elements: cells}
+			rows[index].Shrinker = shrinker.CollectionElements(rows[index])
+		}
+
+		filter := arbitrary.FilterPredicate(target, func(in reflect.Value) bool {
+			return in.Field(0).Len() >= int(limits.Min)
+		})
+
+		arb := sameLength(target)(arbitrary.Arbitrary{Elements: rows})
+		arb.Shrinker = shrinker.Collection().
+			TransformAfter(sameLength(target)).
+			Filter(filter)
+
+		return arb, nil
+	}
+}
+
+// sameLength returns transform that sets struct arbitrary's value from it's rows.
+func sameLength(target reflect.Type) func(arbitrary.Arbitrary) arbitrary.Arbitrary {
+	return func(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+		arb.Value = reflect.New(target).Elem()
+		for field := 0; field < target.NumField(); field++ {
+			slice := reflect.MakeSlice(target.Field(field).Type, len(arb.Elements), len(arb.Elements))
+			for index, row := range arb.Elements {
+				slice.Index(index).Set(row.Elements[field].Value)
+			}
+			reflect.NewAt(
+				arb.Value.Field(field).Type(),
+				unsafe.Pointer(arb.Value.Field(field).UnsafeAddr()),
+			).Elem().Set(slice)
+		}
+		return arb
+	}
+}
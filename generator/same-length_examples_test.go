@@ -0,0 +1,38 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use SameLength() generator for generation of column data:
+// struct whose fields are slices of the same length.
+func ExampleSameLength() {
+	type Columns struct {
+		Names []string
+		Ages  []uint8
+	}
+
+	streamer := generator.Streamer(
+		func(c Columns) {
+			fmt.Printf("%+v\n", c)
+		},
+		generator.SameLength(
+			constraints.Length{Min: 1, Max: 4},
+			generator.StringMatching("[A-Z][a-z]{2,4}"),
+			generator.Uint8(constraints.Uint8{Min: 18, Max: 99}),
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// {Names:[Wqw Wda Drii] Ages:[87 48 39]}
+	// {Names:[Zps Fjjuj Adex] Ages:[58 59 77]}
+	// {Names:[Yagnp Ptc] Ages:[41 19]}
+	// {Names:[Nytm] Ages:[75]}
+	// {Names:[Nla Xjin] Ages:[94 30]}
+}
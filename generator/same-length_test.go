@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestSameLength(t *testing.T) {
+	type columns struct {
+		IDs   []int
+		names []string
+	}
+
+	type invalid struct {
+		IDs   []int
+		count int
+	}
+
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(invalid) {},
+				SameLength(constraints.LengthDefault(), Int(), Int()),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidNumberOfGenerators": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(columns) {},
+				SameLength(constraints.LengthDefault(), Int()),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidCollectionSize) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidCollectionSize)
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(columns) {},
+				SameLength(constraints.Length{Min: 10, Max: 5}, Int(), String()),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"SameLength": func(t *testing.T) {
+			limits := constraints.Length{Min: 2, Max: 20}
+			err := Stream(0, 100, Streamer(
+				func(c columns) {
+					if len(c.IDs) != len(c.names) {
+						t.Fatalf("Slices have different lengths: %d and %d", len(c.IDs), len(c.names))
+					}
+					if len(c.IDs) < int(limits.Min) || len(c.IDs) > int(limits.Max) {
+						t.Fatalf("Slice length %d is not within limits: %v", len(c.IDs), limits)
+					}
+				},
+				SameLength(limits, Int(), String()),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, SameLength(constraints.Length{Min: 0, Max: 20}, Int(), String()), reflect.TypeOf(columns{}), func(v reflect.Value) bool {
+				c := v.Interface().(columns)
+				if len(c.IDs) != len(c.names) {
+					t.Fatalf("Shrink has slices of different lengths: %d and %d", len(c.IDs), len(c.names))
+				}
+				return len(c.IDs) >= 3
+			})
+
+			expected := columns{IDs: []int{0, 0, 0}, names: []string{"", "", ""}}
+			if !reflect.DeepEqual(shrunk.Interface(), expected) {
+				t.Fatalf("Expected value to be shrunk to: %+v. Got: %+v", expected, shrunk)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
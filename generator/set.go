@@ -0,0 +1,47 @@
+package generator
+
+import (
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// Set returns generator for map types that represent sets: map[K]struct{} and map[K]bool.
+// Set's members (map keys) are generated with generator specified by "element" parameter.
+// For map[K]bool target, value of every member is true. Range of set size values is defined
+// by "limits" parameter. If "limits" parameter is not specified default [0, 100] range is used
+// instead. Sets are shrunk by removing members and by shrinking members, skipping shrinks in
+// which two members become the same. Error is returned if generator's target is not a set
+// type, element generator returns an error, limits.Min > limits.Max, or element generator's
+// pool of values gets exhausted before limits.Min members are generated (see [SliceUnique]).
+func Set(element arbitrary.Generator, limits ...constraints.Length) arbitrary.Generator {
+	constraint := constraints.LengthDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		var member reflect.Value
+		switch {
+		case target.Kind() != reflect.Map:
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Set")
+		case target.Elem().Kind() == reflect.Bool:
+			member = reflect.ValueOf(true).Convert(target.Elem())
+		case target.Elem().Kind() == reflect.Struct && target.Elem().NumField() == 0:
+			member = reflect.Zero(target.Elem())
+		default:
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Set")
+		}
+
+		mapper := arbitrary.Mapper(reflect.SliceOf(target.Key()), target, func(in reflect.Value) reflect.Value {
+			set := reflect.MakeMapWithSize(target, in.Len())
+			for index := 0; index < in.Len(); index++ {
+				set.SetMapIndex(in.Index(index), member)
+			}
+			return set
+		})
+
+		return SliceUnique(element, constraint, nil).Map(mapper)(target, bias, r)
+	}
+}
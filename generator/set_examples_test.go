@@ -0,0 +1,35 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Set() generator for generation of map[string]struct{}
+// values that represent sets of strings.
+func ExampleSet() {
+	streamer := generator.Streamer(
+		func(set map[string]struct{}) {
+			fmt.Printf("%v\n", set)
+		},
+		generator.Set(
+			generator.StringMatching("[a-c]{1,2}"),
+			constraints.Length{
+				Min: 0,
+				Max: 5,
+			},
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// map[a:{} ac:{} b:{} ba:{} bc:{}]
+	// map[a:{} ab:{} b:{} bb:{} c:{}]
+	// map[]
+	// map[a:{} aa:{} ab:{} b:{} ba:{}]
+	// map[ba:{}]
+}
@@ -0,0 +1,73 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestSet(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]int) {},
+				Set(Int()),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidMapValue": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(map[int]int) {},
+				Set(Int()),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"SetSizeWithinConstraints": func(t *testing.T) {
+			limits := constraints.Length{Min: 5, Max: 10}
+			err := Stream(0, 100, Streamer(
+				func(structs map[int]struct{}, bools map[uint8]bool) {
+					if len(structs) < int(limits.Min) || len(structs) > int(limits.Max) {
+						t.Fatalf("Set size %d is not within limits: %v", len(structs), limits)
+					}
+					if len(bools) < int(limits.Min) || len(bools) > int(limits.Max) {
+						t.Fatalf("Set size %d is not within limits: %v", len(bools), limits)
+					}
+					for member, value := range bools {
+						if !value {
+							t.Fatalf("Set member %d is false", member)
+						}
+					}
+				},
+				Set(Int(), limits),
+				Set(Uint8(), limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, Set(Int(constraints.Int{Min: 0, Max: 100}), constraints.Length{Min: 2, Max: 10}), reflect.TypeOf(map[int]struct{}{}), func(v reflect.Value) bool {
+				return v.Len() >= 2
+			})
+
+			expected := map[int]struct{}{0: {}, 1: {}}
+			if !reflect.DeepEqual(shrunk.Interface(), expected) {
+				t.Fatalf("Expected set to be shrunk to: %v. Got: %v", expected, shrunk)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -0,0 +1,44 @@
+package generator
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// shrink generates a value of target type with seed 0 and shrinks it for as long as
+// failing predicate holds. It returns the smallest value for which predicate holds.
+func shrink(t testing.TB, generator arbitrary.Generator, target reflect.Type, failing func(reflect.Value) bool) reflect.Value {
+	t.Helper()
+	return shrinkArbitrary(t, generator, target, failing).Value
+}
+
+// shrinkArbitrary is the same as shrink, except that it returns the smallest arbitrary
+// for which predicate holds.
+func shrinkArbitrary(t testing.TB, generator arbitrary.Generator, target reflect.Type, failing func(reflect.Value) bool) arbitrary.Arbitrary {
+	t.Helper()
+
+	r := arbitrary.RandomNumber{Rand: rand.New(rand.NewSource(0))}
+	arb, err := generator(target, constraints.Bias{Size: 100, Scaling: 1}, r)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !failing(arb.Value) {
+		t.Fatalf("Generated value %v doesn't satisfy failing predicate", arb.Value)
+	}
+
+	last := arb
+	for propertyFailed := true; arb.Shrinker != nil; {
+		if arb, err = arb.Shrinker(arb, propertyFailed); err != nil {
+			t.Fatalf("Unexpected shrinking error: %s", err)
+		}
+		if propertyFailed = failing(arb.Value); propertyFailed {
+			last = arb
+		}
+	}
+
+	return last
+}
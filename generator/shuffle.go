@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
+)
+
+// Shuffle returns generator for slice types that shuffles slices generated by generator
+// specified by "generator" parameter. Every permutation of generated slice is equally likely,
+// as permutation is drawn uniformly, regardless of [constraints.Bias].
+// Shuffled slice is shrunk by shrinking the slice generated by generator and by shrinking the
+// permutation towards the original order of elements. Every shrink is a permutation of the
+// (shrunk) slice generated by generator. Error is returned if generator's target is not a
+// slice type or generator returns an error.
+func Shuffle(generator arbitrary.Generator) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target.Kind() != reflect.Slice {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Shuffle")
+		}
+
+		source, err := generator(target, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, fmt.Errorf("failed to use shuffle's slice generator. %w", err)
+		}
+
+		// Permutation is encoded using Lehmer code, where i-th digit is the index of the element
+		// (among the elements that are not yet used) that is placed at i-th position. Code with
+		// all digits equal to 0 encodes the original order of elements. Digits are drawn
+		// uniformly, so that every permutation is equally likely.
+		size := source.Value.Len()
+		digits := make([]arbitrary.Generator, size)
+		for index := range digits {
+			digits[index] = lehmerDigit(constraints.Uint64{
+				Min: 0,
+				Max: uint64(size - 1 - index),
+			})
+		}
+
+		code, err := ArrayFrom(digits...)(reflect.ArrayOf(size, reflect.TypeOf(uint64(0))), bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, fmt.Errorf("failed to generate permutation. %w", err)
+		}
+
+		arb := permute(arbitrary.Arbitrary{
+			Elements: arbitrary.Arbitraries{source, code},
+		})
+		arb.Shrinker = shrinker.CollectionElements(arb).TransformAfter(permute)
+
+		return arb, nil
+	}
+}
+
+// Permutation returns generator for slice types that generates permutations of the slice
+// specified by "slice" parameter. Generated permutations are shrunk towards the original
+// order of slice's elements. Error is returned if slice is not a slice, or if slice's type
+// doesn't match generator's target.
+func Permutation(slice interface{}) arbitrary.Generator {
+	if reflect.TypeOf(slice) == nil || reflect.TypeOf(slice).Kind() != reflect.Slice {
+		return Invalid(fmt.Errorf("%w. Permutation requires a slice. Got: %T", arbitrary.ErrorInvalidConfig, slice))
+	}
+
+	shuffle := Shuffle(Constant(slice))
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf(slice) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Permutation")
+		}
+		return shuffle(target, bias, r)
+	}
+}
+
+// lehmerDigit returns generator of Lehmer code's digits within "limits". Digit is drawn
+// uniformly, ignoring bias, and it's shrunk towards limits.Min.
+func lehmerDigit(limits constraints.Uint64) arbitrary.Generator {
+	return func(target reflect.Type, _ constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		return arbitrary.Arbitrary{
+			Value:    reflect.ValueOf(r.Uint64(limits)),
+			Shrinker: shrinker.Uint64(limits),
+		}, nil
+	}
+}
+
+// permute sets arbitrary's value to a permutation of it's first element's value (slice)
+// encoded by it's second element's value (Lehmer code).
+func permute(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+	source, code := arb.Elements[0].Value, arb.Elements[1].Value
+
+	remaining := make([]reflect.Value, source.Len())
+	for index := range remaining {
+		remaining[index] = source.Index(index)
+	}
+
+	arb.Value = reflect.MakeSlice(source.Type(), source.Len(), source.Len())
+	for index := 0; index < arb.Value.Len(); index++ {
+		digit := 0
+		if index < code.Len() {
+			digit = int(code.Index(index).Uint() % uint64(len(remaining)))
+		}
+		arb.Value.Index(index).Set(remaining[digit])
+		remaining = append(remaining[:digit], remaining[digit+1:]...)
+	}
+
+	return arb
+}
@@ -0,0 +1,59 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Shuffle() generator for generation of shuffled slices.
+// Slices generated by SortedSlice() generator are shuffled, thus generated values are
+// permutations of sorted slices.
+func ExampleShuffle() {
+	streamer := generator.Streamer(
+		func(ints []int) {
+			fmt.Printf("%#v\n", ints)
+		},
+		generator.Shuffle(
+			generator.SortedSlice(
+				generator.Int(constraints.Int{Min: 0, Max: 100}),
+				func(a, b int) bool {
+					return a < b
+				},
+				constraints.Length{Min: 0, Max: 10},
+			),
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// []int{86, 80, 16, 69, 31}
+	// []int{79, 71, 95, 50, 57, 21, 92, 76}
+	// []int{67}
+	// []int{14, 59, 44, 57, 56, 52, 30}
+	// []int{19, 40, 84, 12, 61, 68}
+}
+
+// This example demonstrates how to use Permutation() generator for generation of permutations
+// of a slice.
+func ExamplePermutation() {
+	streamer := generator.Streamer(
+		func(s []string) {
+			fmt.Printf("%#v\n", s)
+		},
+		generator.Permutation([]string{"a", "b", "c", "d"}),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// []string{"c", "d", "a", "b"}
+	// []string{"b", "c", "a", "d"}
+	// []string{"d", "b", "c", "a"}
+	// []string{"a", "c", "b", "d"}
+	// []string{"b", "a", "d", "c"}
+}
@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestShuffle(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([3]int) {},
+				Shuffle(Array(Int())),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"Permutation": func(t *testing.T) {
+			seen := map[[4]int]bool{}
+			err := Stream(0, 500, Streamer(
+				func(ns []int) {
+					sorted := append([]int{}, ns...)
+					sort.Ints(sorted)
+					if !reflect.DeepEqual(sorted, []int{1, 2, 3, 4}) {
+						t.Fatalf("%v is not a permutation of [1 2 3 4]", ns)
+					}
+					seen[[4]int{ns[0], ns[1], ns[2], ns[3]}] = true
+				},
+				Shuffle(Constant([]int{1, 2, 3, 4})),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(seen) != 24 {
+				t.Fatalf("Expected all 24 permutations to be generated. Got: %d", len(seen))
+			}
+		},
+		"EquallyLikely": func(t *testing.T) {
+			counts := map[[3]int]int{}
+			err := Stream(0, 6000, Streamer(
+				func(ns []int) {
+					counts[[3]int{ns[0], ns[1], ns[2]}]++
+				},
+				Shuffle(Constant([]int{1, 2, 3})),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			for permutation, count := range counts {
+				if count < 800 || count > 1200 {
+					t.Fatalf("Permutation %v is generated %d times out of 6000", permutation, count)
+				}
+			}
+		},
+		"ShrinkTowardsOriginalOrder": func(t *testing.T) {
+			source := []int{1, 2, 3, 4, 5, 6, 7, 8}
+			shrunk := shrink(t, Shuffle(Constant(source)), reflect.TypeOf([]int{}), func(reflect.Value) bool {
+				return true
+			})
+
+			if !reflect.DeepEqual(shrunk.Interface(), source) {
+				t.Fatalf("Expected slice to be shrunk to: %v. Got: %v", source, shrunk)
+			}
+		},
+		"ShrinkKeepsPermutation": func(t *testing.T) {
+			shrunk := shrink(t, Shuffle(Slice(Int(constraints.Int{Min: 0, Max: 100}), constraints.Length{Min: 2, Max: 10})), reflect.TypeOf([]int{}), func(v reflect.Value) bool {
+				ns := v.Interface().([]int)
+				return len(ns) >= 2 && ns[0] > ns[1]
+			})
+
+			if expected := []int{1, 0}; !reflect.DeepEqual(shrunk.Interface(), expected) {
+				t.Fatalf("Expected slice to be shrunk to: %v. Got: %v", expected, shrunk)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
+
+func TestPermutation(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidSlice": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]int) {},
+				Permutation(5),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConfig)
+			}
+		},
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]uint) {},
+				Permutation([]int{1, 2, 3}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"Permutation": func(t *testing.T) {
+			source := []string{"a", "b", "c", "d", "e"}
+			err := Stream(0, 100, Streamer(
+				func(s []string) {
+					sorted := append([]string{}, s...)
+					sort.Strings(sorted)
+					if !reflect.DeepEqual(sorted, source) {
+						t.Fatalf("%v is not a permutation of %v", s, source)
+					}
+				},
+				Permutation(source),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(source, []string{"a", "b", "c", "d", "e"}) {
+				t.Fatalf("Source slice was modified: %v", source)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -0,0 +1,150 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
+)
+
+// uniqueAttempts is the number of consecutive attempts to generate a value that is not a
+// duplicate of previously generated values, per each of the previously generated values.
+const uniqueAttempts = 1000
+
+// errorExhausted indicates that unique value can't be generated, as pool of values that
+// generator can generate is exhausted.
+var errorExhausted = fmt.Errorf("pool of values is exhausted")
+
+// unique returns generator that uses generator to generate value for which exists returns
+// false. Number of consecutive attempts grows with the number of values that were "found" so
+// far, so that the last values of the pool that is the same size as the collection are found
+// as well. Error is returned if generator returns an error, or errorExhausted is returned if
+// unique value isn't generated within uniqueAttempts * (found + 1) consecutive attempts.
+func unique(generator arbitrary.Generator, exists func(reflect.Value) bool, found int) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		attempts := uniqueAttempts * (found + 1)
+		for attempt := 0; attempt < attempts; attempt++ {
+			arb, err := generator(target, bias, r)
+			if err != nil {
+				return arbitrary.Arbitrary{}, err
+			}
+			if !exists(arb.Value) {
+				return arb, nil
+			}
+		}
+		return arbitrary.Arbitrary{}, fmt.Errorf("%w. Failed to generate unique %s value in %d attempts", errorExhausted, target, attempts)
+	}
+}
+
+// uniqueExhausted returns error for collection whose "found" unique values are fewer than
+// "min", after pool of values is exhausted.
+func uniqueExhausted(err error, found int, min uint64) error {
+	return fmt.Errorf("%w. Only %d unique values can be generated, minimal length is %d. %s", arbitrary.ErrorInvalidConstraints, found, min, err)
+}
+
+// SliceUnique returns generator for slice types whose elements are unique. Slice elements are
+// generated with generator specified by "element" parameter. Range of slice size values is defined
+// by "limits" parameter. Uniqueness of elements is determined by comparing their keys returned by
+// "key" parameter, which must be a function with one input (slice element type) and one output of
+// a comparable type. If key is nil, elements are compared directly and slice element type must be
+// comparable. Generated slices are shrunk the same way as slices generated by [Slice] generator,
+// skipping shrinks that contain duplicate elements. If element generator's pool of values gets
+// exhausted, slice is generated with the elements generated until then. Exhaustion is detected
+// heuristically, as size of the pool isn't known: pool is considered exhausted once unique element
+// isn't generated within 1000 consecutive attempts per each element generated so far. Error is returned
+// if generator's target is not a slice type, key is invalid, element generator returns an error,
+// limits.Min > limits.Max, or pool of values gets exhausted before limits.Min elements are generated.
+func SliceUnique(elementGenerator arbitrary.Generator, limits constraints.Length, key interface{}) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		switch {
+		case target.Kind() != reflect.Slice:
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "SliceUnique")
+		case limits.Min > limits.Max:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Minimal length value %d can't be greater than max length value %d", arbitrary.ErrorInvalidConstraints, limits.Min, limits.Max)
+		case limits.Max > uint64(math.MaxInt64):
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Max length %d can't be greater than %d", arbitrary.ErrorInvalidConstraints, limits.Max, uint64(math.MaxInt64))
+		}
+
+		keyOf, err := uniqueKey(target.Elem(), key)
+		if err != nil {
+			return arbitrary.Arbitrary{}, err
+		}
+
+		size := r.Uint64(constraints.Uint64(limits))
+		value := reflect.MakeSlice(target, int(size), int(size))
+		elements := make(arbitrary.Arbitraries, size)
+		keys := make(map[interface{}]struct{}, size)
+
+		exists := func(element reflect.Value) bool {
+			_, exists := keys[keyOf(element)]
+			return exists
+		}
+
+		for index := range elements {
+			elements[index], err = unique(elementGenerator, exists, index)(target.Elem(), bias, r)
+			if errors.Is(err, errorExhausted) && uint64(index) >= limits.Min {
+				value, elements = value.Slice(0, index), elements[:index]
+				break
+			}
+			switch {
+			case errors.Is(err, errorExhausted):
+				return arbitrary.Arbitrary{}, uniqueExhausted(err, index, limits.Min)
+			case err != nil:
+				return arbitrary.Arbitrary{}, fmt.Errorf("failed to use slice element generator. %w", err)
+			}
+			keys[keyOf(elements[index].Value)] = struct{}{}
+			value.Index(index).Set(elements[index].Value)
+		}
+
+		distinct := arbitrary.FilterPredicate(target, func(in reflect.Value) bool {
+			keys := make(map[interface{}]struct{}, in.Len())
+			for index := 0; index < in.Len(); index++ {
+				keys[keyOf(in.Index(index))] = struct{}{}
+			}
+			return len(keys) == in.Len()
+		})
+
+		arb := arbitrary.Arbitrary{
+			Value:    value,
+			Elements: elements,
+		}
+
+		arb.Shrinker = shrinker.Slice(arb, limits).Filter(distinct)
+
+		return arb, nil
+	}
+}
+
+// uniqueKey returns function that returns comparable key of the value of element type
+// using key function. If key function is nil, value itself is used as a key. Error is
+// returned if key function is invalid or element type is not comparable.
+func uniqueKey(element reflect.Type, key interface{}) (func(reflect.Value) interface{}, error) {
+	if key == nil {
+		if !element.Comparable() {
+			return nil, fmt.Errorf("%w. Element type %s is not comparable, key function must be provided", arbitrary.ErrorInvalidConfig, element)
+		}
+		return func(in reflect.Value) interface{} {
+			return in.Interface()
+		}, nil
+	}
+
+	val := reflect.ValueOf(key)
+	switch {
+	case val.Kind() != reflect.Func:
+		return nil, fmt.Errorf("%w. Key must be a function", arbitrary.ErrorInvalidConfig)
+	case val.Type().NumIn() != 1 || val.Type().NumOut() != 1:
+		return nil, fmt.Errorf("%w. Key function must have 1 input and 1 output value", arbitrary.ErrorInvalidConfig)
+	case val.Type().In(0) != element:
+		return nil, fmt.Errorf("%w. Key function's input type %s must match element type %s", arbitrary.ErrorInvalidConfig, val.Type().In(0), element)
+	case !val.Type().Out(0).Comparable():
+		return nil, fmt.Errorf("%w. Key function's output type %s must be comparable", arbitrary.ErrorInvalidConfig, val.Type().Out(0))
+	}
+
+	return func(in reflect.Value) interface{} {
+		return val.Call([]reflect.Value{in})[0].Interface()
+	}, nil
+}
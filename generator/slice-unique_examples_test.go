@@ -0,0 +1,78 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use SliceUnique() generator for generation of []int values
+// whose elements are unique. Key function is not provided, so elements are compared directly.
+func ExampleSliceUnique() {
+	streamer := generator.Streamer(
+		func(ints []int) {
+			fmt.Printf("%#v\n", ints)
+		},
+		generator.SliceUnique(
+			generator.Int(constraints.Int{
+				Min: 0,
+				Max: 10,
+			}),
+			constraints.Length{
+				Min: 3,
+				Max: 8,
+			},
+			nil,
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// []int{2, 6, 0, 1, 5, 4, 3, 8}
+	// []int{5, 7, 2}
+	// []int{10, 2, 8, 5}
+	// []int{9, 4, 0, 1, 3, 7}
+	// []int{4, 9, 8, 0, 6, 7}
+}
+
+// This example demonstrates how to use SliceUnique() generator with key function. Key function
+// defines the part of the element that must be unique, in this case user's ID.
+func ExampleSliceUnique_key() {
+	type User struct {
+		ID   int
+		Name string
+	}
+
+	streamer := generator.Streamer(
+		func(users []User) {
+			fmt.Printf("%+v\n", users)
+		},
+		generator.SliceUnique(
+			generator.Struct(map[string]arbitrary.Generator{
+				"ID":   generator.Int(constraints.Int{Min: 0, Max: 5}),
+				"Name": generator.StringMatching("[A-Z][a-z]{2,5}"),
+			}),
+			constraints.Length{
+				Min: 2,
+				Max: 4,
+			},
+			func(user User) int {
+				return user.ID
+			},
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// [{ID:2 Name:Wqw} {ID:1 Name:Fadnri} {ID:0 Name:Vzmps}]
+	// [{ID:5 Name:Tjuj} {ID:1 Name:Adex}]
+	// [{ID:4 Name:Zoa} {ID:5 Name:Pmxp}]
+	// [{ID:5 Name:Tbnmw} {ID:4 Name:Uny}]
+	// [{ID:1 Name:Zylamx} {ID:5 Name:Jnz}]
+}
@@ -0,0 +1,179 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestSliceUnique(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(int) {},
+				SliceUnique(Int(), constraints.LengthDefault(), nil),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]int) {},
+				SliceUnique(Int(), constraints.Length{Min: 10, Max: 5}, nil),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"InvalidKey": func(t *testing.T) {
+			keys := map[string]interface{}{
+				"NotFunction":    5,
+				"InvalidInput":   func(string) int { return 0 },
+				"InvalidOutputs": func(int) (int, int) { return 0, 0 },
+				"NotComparable":  func(int) []int { return nil },
+			}
+
+			for name, key := range keys {
+				err := Stream(0, 10, Streamer(
+					func([]int) {},
+					SliceUnique(Int(), constraints.LengthDefault(), key),
+				))
+
+				if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+					t.Fatalf("%s: Expected error: '%s'", name, arbitrary.ErrorInvalidConfig)
+				}
+			}
+		},
+		"NotComparableElement": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([][]int) {},
+				SliceUnique(Slice(Int()), constraints.LengthDefault(), nil),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConfig)
+			}
+		},
+		"ExhaustedPool": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]int) {},
+				SliceUnique(Int(constraints.Int{Min: 0, Max: 5}), constraints.Length{Min: 7, Max: 7}, nil),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"ExhaustedElementType": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]bool) {},
+				SliceUnique(Bool(), constraints.Length{Min: 3, Max: 3}, nil),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"PoolSmallerThanMax": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(ns []int) {
+					if len(ns) < 2 || len(ns) > 6 {
+						t.Fatalf("Slice size %d is not within [2, 6]", len(ns))
+					}
+				},
+				SliceUnique(Int(constraints.Int{Min: 0, Max: 5}), constraints.Length{Min: 2, Max: 10}, nil),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"PoolSizedToSlice": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(ns []int) {
+					if len(ns) != 1000 {
+						t.Fatalf("Expected slice size 1000, got: %d", len(ns))
+					}
+				},
+				SliceUnique(Int(constraints.Int{Min: 0, Max: 999}), constraints.Length{Min: 1000, Max: 1000}, nil),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"UniqueElements": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(ns []int) {
+					seen := map[int]bool{}
+					for _, n := range ns {
+						if seen[n] {
+							t.Fatalf("Duplicate element %d in: %v", n, ns)
+						}
+						seen[n] = true
+					}
+				},
+				SliceUnique(Int(constraints.Int{Min: 0, Max: 20}), constraints.Length{Min: 0, Max: 21}, nil),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"UniqueKeys": func(t *testing.T) {
+			type user struct {
+				ID   int
+				Tags []bool
+			}
+			key := func(u user) int {
+				return u.ID
+			}
+
+			err := Stream(0, 100, Streamer(
+				func(users []user) {
+					seen := map[int]bool{}
+					for _, u := range users {
+						if seen[u.ID] {
+							t.Fatalf("Duplicate user ID %d", u.ID)
+						}
+						seen[u.ID] = true
+					}
+				},
+				SliceUnique(Struct(map[string]arbitrary.Generator{
+					"ID":   Int(constraints.Int{Min: 0, Max: 10}),
+					"Tags": Slice(Bool(), constraints.Length{Min: 0, Max: 3}),
+				}), constraints.Length{Min: 5, Max: 10}, key),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"ShrinkKeepsUniqueness": func(t *testing.T) {
+			shrunk := shrink(t, SliceUnique(Int(constraints.Int{Min: 0, Max: 1000}), constraints.Length{Min: 3, Max: 10}, nil), reflect.TypeOf([]int{}), func(v reflect.Value) bool {
+				seen := map[int]bool{}
+				for _, n := range v.Interface().([]int) {
+					if seen[n] {
+						t.Fatalf("Duplicate element %d in shrink: %v", n, v)
+					}
+					seen[n] = true
+				}
+				return true
+			})
+
+			if ns := shrunk.Interface().([]int); len(ns) != 3 {
+				t.Fatalf("Expected slice to be shrunk to 3 elements. Got: %v", ns)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -0,0 +1,73 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
+)
+
+// SortedSlice returns generator for slice types whose elements are sorted in ascending order
+// defined by "less" parameter. Less must be a function with two inputs of slice's element type
+// and bool output, that reports whether first input must sort before the second one. Slice
+// elements are generated with generator specified by "element" parameter and range of slice
+// size values is defined by "limits" parameter. If "limits" parameter is not specified default
+// [0, 100] range is used instead. Generated slices are shrunk without breaking their order: by
+// removing runs of elements and single elements, and by shrinking each element with it's own
+// shrinker, skipping shrinks after which slice is no longer sorted. Error is returned if
+// generator's target is not a slice type, less is invalid, element generator returns an error
+// or limits.Min > limits.Max.
+func SortedSlice(element arbitrary.Generator, less interface{}, limits ...constraints.Length) arbitrary.Generator {
+	constraint := constraints.LengthDefault()
+	if len(limits) != 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target.Kind() != reflect.Slice {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "SortedSlice")
+		}
+
+		val := reflect.ValueOf(less)
+		switch {
+		case val.Kind() != reflect.Func:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Less must be a function", arbitrary.ErrorInvalidConfig)
+		case val.Type().NumIn() != 2 || val.Type().In(0) != target.Elem() || val.Type().In(1) != target.Elem():
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Less must have 2 inputs of type %s", arbitrary.ErrorInvalidConfig, target.Elem())
+		case val.Type().NumOut() != 1 || val.Type().Out(0).Kind() != reflect.Bool:
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Less must have bool as it's only output", arbitrary.ErrorInvalidConfig)
+		}
+
+		arb, err := Slice(element, constraint)(target, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, err
+		}
+
+		isLess := func(a, b reflect.Value) bool {
+			return val.Call([]reflect.Value{a, b})[0].Bool()
+		}
+
+		elements := append(arbitrary.Arbitraries(nil), arb.Elements...)
+		sort.SliceStable(elements, func(i, j int) bool {
+			return isLess(elements[i].Value, elements[j].Value)
+		})
+		arb = arbitrary.NewSlice(target)(arbitrary.Arbitrary{Elements: elements})
+
+		sorted := arbitrary.FilterPredicate(target, func(in reflect.Value) bool {
+			for index := 1; index < in.Len(); index++ {
+				if isLess(in.Index(index), in.Index(index-1)) {
+					return false
+				}
+			}
+			return true
+		})
+
+		runs := shrinker.CollectionRuns(constraint).TransformAfter(arbitrary.NewSlice(target))
+		arb.Shrinker = shrinker.Chain(runs, shrinker.Slice(arb, constraint)).Filter(sorted)
+
+		return arb, nil
+	}
+}
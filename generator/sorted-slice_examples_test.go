@@ -0,0 +1,41 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use SortedSlice() generator for generation of sorted []int
+// values. Order of elements is defined by less function.
+func ExampleSortedSlice() {
+	streamer := generator.Streamer(
+		func(ints []int) {
+			fmt.Printf("%#v\n", ints)
+		},
+		generator.SortedSlice(
+			generator.Int(constraints.Int{
+				Min: 0,
+				Max: 100,
+			}),
+			func(a, b int) bool {
+				return a < b
+			},
+			constraints.Length{
+				Min: 0,
+				Max: 10,
+			},
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// []int{16, 31, 69, 80, 86}
+	// []int{3, 3, 30, 64, 84, 91}
+	// []int{31}
+	// []int{21, 50, 57, 62, 71, 76, 79, 92}
+	// []int{40, 95}
+}
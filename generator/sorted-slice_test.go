@@ -0,0 +1,96 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestSortedSlice(t *testing.T) {
+	less := func(a, b int) bool {
+		return a < b
+	}
+
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(int) {},
+				SortedSlice(Int(), less),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidLess": func(t *testing.T) {
+			lessFuncs := map[string]interface{}{
+				"NotFunction":   5,
+				"InvalidInputs": func(a int) bool { return false },
+				"InvalidType":   func(a, b uint) bool { return false },
+				"InvalidOutput": func(a, b int) int { return 0 },
+			}
+
+			for name, less := range lessFuncs {
+				err := Stream(0, 10, Streamer(
+					func([]int) {},
+					SortedSlice(Int(), less),
+				))
+
+				if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+					t.Fatalf("%s: Expected error: '%s'", name, arbitrary.ErrorInvalidConfig)
+				}
+			}
+		},
+		"Sorted": func(t *testing.T) {
+			limits := constraints.Length{Min: 2, Max: 20}
+			err := Stream(0, 100, Streamer(
+				func(ns []int) {
+					if len(ns) < int(limits.Min) || len(ns) > int(limits.Max) {
+						t.Fatalf("Slice length %d is not within limits: %v", len(ns), limits)
+					}
+					if !sort.IntsAreSorted(ns) {
+						t.Fatalf("Slice is not sorted: %v", ns)
+					}
+				},
+				SortedSlice(Int(), less, limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"ShrinkKeepsSortedness": func(t *testing.T) {
+			shrunk := shrink(t, SortedSlice(Int(constraints.Int{Min: -100, Max: 100}), less, constraints.Length{Min: 5, Max: 10}), reflect.TypeOf([]int{}), func(v reflect.Value) bool {
+				if ns := v.Interface().([]int); !sort.IntsAreSorted(ns) {
+					t.Fatalf("Shrink is not sorted: %v", ns)
+				}
+				return true
+			})
+
+			if expected := []int{0, 0, 0, 0, 0}; !reflect.DeepEqual(shrunk.Interface(), expected) {
+				t.Fatalf("Expected slice to be shrunk to: %v. Got: %v", expected, shrunk)
+			}
+		},
+		"ShrinkTowardsNeighbours": func(t *testing.T) {
+			shrunk := shrink(t, SortedSlice(Int(constraints.Int{Min: -100, Max: 100}), less, constraints.Length{Min: 3, Max: 20}), reflect.TypeOf([]int{}), func(v reflect.Value) bool {
+				ns := v.Interface().([]int)
+				if !sort.IntsAreSorted(ns) {
+					t.Fatalf("Shrink is not sorted: %v", ns)
+				}
+				return ns[len(ns)-1]-ns[0] >= 50 && ns[len(ns)-1] > 20
+			})
+
+			if expected := []int{0, 0, 50}; !reflect.DeepEqual(shrunk.Interface(), expected) {
+				t.Fatalf("Expected slice to be shrunk to: %v. Got: %v", expected, shrunk)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
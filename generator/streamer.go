@@ -36,7 +36,10 @@ func Streamer(target interface{}, generators ...arbitrary.Generator) streamer {
 			arbs[index] = arb
 		}
 
-		targetVal.Call(arbs.Values())
+		done := make(chan struct{})
+		defer close(done)
+
+		targetVal.Call(arbs.Feed(done))
 		return nil
 	}
 }
@@ -5,25 +5,65 @@ import (
 
 	"github.com/steffnova/go-check/arbitrary"
 	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
 )
 
+// stringParts is an intermediate representation of a string, whose runes are
+// converted with the normalization form with index Form.
+type stringParts struct {
+	Runes []rune
+	Form  uint64
+}
+
 // String returns generator for string types. Range of slice size is defined by
 // "limits" parameter. If "limits" parameter is not specified default [0, 100]
-// range is used instead. Error is returned if generator's target is not a
-// string type, or limits.Min > limits.Max
+// range is used instead. String's runes are generated with [Rune] generator
+// using limits.Rune. If limits.Forms are specified, one of them is applied to
+// each generated string (e.g. to get both NFC and NFD variants of strings),
+// shrinking towards the first one. Forms can change the number of string's
+// runes, see [constraints.String]. Strings are shrunk by removing runs of
+// runes first, and then by removing and shrinking single runes. Error is
+// returned if generator's target is not a string type, or limits.Min > limits.Max
 func String(limits ...constraints.String) arbitrary.Generator {
 	constraint := constraints.StringDefault()
 	if len(limits) != 0 {
 		constraint = limits[0]
 	}
+	runeSlice := runes(constraint)
 
 	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
-		mapper := arbitrary.Mapper(reflect.TypeOf([]rune{}), target, func(in reflect.Value) reflect.Value {
-			return in.Convert(target)
+		if len(constraint.Forms) == 0 {
+			convertFn := func(in reflect.Value) reflect.Value {
+				return in.Convert(target)
+			}
+			return convert(runeSlice, reflect.TypeOf([]rune{}), convertFn)(target, bias, r)
+		}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(stringParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(stringParts)
+			return reflect.ValueOf(constraint.Forms[parts.Form](string(parts.Runes))).Convert(target)
 		})
-		return Slice(
-			Rune(constraint.Rune),
-			constraint.Length,
-		).Map(mapper)(target, bias, r)
+		return Struct(map[string]arbitrary.Generator{
+			"Runes": runeSlice,
+			"Form":  Uint64(constraints.Uint64{Min: 0, Max: uint64(len(constraint.Forms) - 1)}),
+		}).Map(mapper)(target, bias, r)
+	}
+}
+
+// runes returns generator for slice of runes used by [String] generator. Generated
+// slices are shrunk by removing runs of runes first, before being shrunk as any
+// other slice.
+func runes(constraint constraints.String) arbitrary.Generator {
+	slice := Slice(Rune(constraint.Rune), constraint.Length)
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		arb, err := slice(target, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, err
+		}
+
+		runs := shrinker.CollectionRuns(constraint.Length).TransformAfter(arbitrary.NewSlice(target))
+		arb.Shrinker = shrinker.Chain(runs, arb.Shrinker)
+		return arb, nil
 	}
 }
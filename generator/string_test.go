@@ -2,7 +2,10 @@ package generator
 
 import (
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/steffnova/go-check/arbitrary"
 	"github.com/steffnova/go-check/constraints"
@@ -67,6 +70,54 @@ func TestString(t *testing.T) {
 				t.Fatalf("Unexpected error: %s", err)
 			}
 		},
+		"Forms": func(t *testing.T) {
+			forms := map[string]int{}
+			err := Stream(0, 100, Streamer(
+				func(s string) {
+					switch s {
+					case strings.ToLower(s):
+						forms["lower"]++
+					case strings.ToUpper(s):
+						forms["upper"]++
+					default:
+						t.Fatalf("String %q is neither lower nor upper case", s)
+					}
+				},
+				String(constraints.String{
+					Rune:   constraints.Rune{MinCodePoint: 'a', MaxCodePoint: 'z'},
+					Length: constraints.Length{Min: 1, Max: 10},
+					Forms:  []func(string) string{strings.ToLower, strings.ToUpper},
+				}),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if forms["lower"] == 0 || forms["upper"] == 0 {
+				t.Fatalf("Expected strings of both forms. Got: %v", forms)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, String(), reflect.TypeOf(""), func(v reflect.Value) bool {
+				return len([]rune(v.String())) >= 5
+			})
+
+			if shrunk.String() != "aaaaa" {
+				t.Fatalf("Expected string to be shrunk to \"aaaaa\". Got: %q", shrunk.String())
+			}
+		},
+		"ShrinkToDigit": func(t *testing.T) {
+			constraint := constraints.StringDefault()
+			constraint.Rune = constraints.Rune{MinCodePoint: 0, MaxCodePoint: unicode.MaxASCII}
+
+			shrunk := shrink(t, String(constraint), reflect.TypeOf(""), func(v reflect.Value) bool {
+				return strings.ContainsAny(v.String(), "0123456789")
+			})
+
+			if shrunk.String() != "0" {
+				t.Fatalf("Expected string to be shrunk to \"0\". Got: %q", shrunk.String())
+			}
+		},
 	}
 
 	for name, testCase := range testCases {
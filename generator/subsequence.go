@@ -0,0 +1,41 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// SubsequenceOf returns generator for slice types that generates subsequences of the slice
+// specified by "slice" parameter. Subsequence contains any number of slice's elements in the
+// order they appear in the slice. Generated subsequences are shrunk by removing elements,
+// towards an empty slice. Error is returned if slice is not a slice, or if slice's type doesn't
+// match generator's target.
+func SubsequenceOf(slice interface{}) arbitrary.Generator {
+	if reflect.TypeOf(slice) == nil || reflect.TypeOf(slice).Kind() != reflect.Slice {
+		return Invalid(fmt.Errorf("%w. SubsequenceOf requires a slice. Got: %T", arbitrary.ErrorInvalidConfig, slice))
+	}
+
+	source := reflect.ValueOf(slice)
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != source.Type() {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "SubsequenceOf")
+		}
+
+		mask := reflect.ArrayOf(source.Len(), reflect.TypeOf(false))
+		mapper := arbitrary.Mapper(mask, target, func(in reflect.Value) reflect.Value {
+			subsequence := reflect.MakeSlice(target, 0, source.Len())
+			for index := 0; index < in.Len(); index++ {
+				if in.Index(index).Bool() {
+					subsequence = reflect.Append(subsequence, source.Index(index))
+				}
+			}
+			return subsequence
+		})
+
+		return Array(Bool()).Map(mapper)(target, bias, r)
+	}
+}
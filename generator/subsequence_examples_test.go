@@ -0,0 +1,28 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use SubsequenceOf() generator for generation of subsequences
+// of a slice.
+func ExampleSubsequenceOf() {
+	streamer := generator.Streamer(
+		func(ints []int) {
+			fmt.Printf("%#v\n", ints)
+		},
+		generator.SubsequenceOf([]int{1, 2, 3, 4, 5, 6, 7, 8}),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// []int{1, 4, 5, 8}
+	// []int{1, 4, 6, 7}
+	// []int{1, 3, 4, 5, 6, 8}
+	// []int{1, 3, 7}
+	// []int{2, 3, 5, 7, 8}
+}
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+func TestSubsequenceOf(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidSlice": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]int) {},
+				SubsequenceOf(map[int]int{}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConfig)
+			}
+		},
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]uint) {},
+				SubsequenceOf([]int{1, 2, 3}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"Subsequence": func(t *testing.T) {
+			source := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+			err := Stream(0, 100, Streamer(
+				func(ns []int) {
+					index := 0
+					for _, n := range ns {
+						for index < len(source) && source[index] != n {
+							index++
+						}
+						if index == len(source) {
+							t.Fatalf("%v is not a subsequence of %v", ns, source)
+						}
+						index++
+					}
+				},
+				SubsequenceOf(source),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, SubsequenceOf([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}), reflect.TypeOf([]int{}), func(v reflect.Value) bool {
+				return v.Len() >= 2
+			})
+
+			if shrunk.Len() != 2 {
+				t.Fatalf("Expected subsequence to be shrunk to 2 elements. Got: %v", shrunk)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -0,0 +1,145 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// timeParts is an intermediate representation of time.Time value. Seconds are
+// relative to the anchor defined by [constraints.Time].
+type timeParts struct {
+	Seconds  int64
+	Nanos    int64
+	Location *time.Location
+}
+
+// Time returns generator for time.Time type. Range of time values that can be generated
+// is defined by "limits" parameter. If no limits are provided [constraints.TimeDefault] is
+// used instead. Generated values are shrunk towards [constraints.Time.Anchor] (truncated to
+// a second), or towards Unix epoch if anchor is not specified. Location of each generated
+// value is one of [constraints.Time.Locations], shrinking towards the first one, or UTC if
+// no locations are specified. Locations with daylight saving time can be used to cover time
+// arithmetic across DST transitions. Generated values never carry a monotonic clock reading.
+// Error is returned if generator's target is not time.Time, limits.Min is after limits.Max,
+// or any of the locations is nil.
+func Time(limits ...constraints.Time) arbitrary.Generator {
+	constraint := constraints.TimeDefault()
+	if len(limits) > 0 {
+		constraint = limits[0]
+	}
+	if constraint.Anchor.IsZero() {
+		constraint.Anchor = time.Unix(0, 0)
+	}
+	if len(constraint.Locations) == 0 {
+		constraint.Locations = []*time.Location{time.UTC}
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf(time.Time{}) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Time")
+		}
+		if constraint.Min.After(constraint.Max) {
+			return arbitrary.Arbitrary{}, fmt.Errorf("%w. Lower limit: %s cannot be after upper limit: %s", arbitrary.ErrorInvalidConstraints, constraint.Min, constraint.Max)
+		}
+
+		anchor := constraint.Anchor.Unix()
+		switch {
+		case constraint.Anchor.Before(constraint.Min):
+			anchor = constraint.Min.Unix()
+		case constraint.Anchor.After(constraint.Max):
+			anchor = constraint.Max.Unix()
+		}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(timeParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(timeParts)
+			seconds := anchor + parts.Seconds
+
+			// Nanoseconds of the first and the last second within limits are mapped into
+			// the range of nanoseconds allowed by limits, so that limits are not more
+			// likely to be generated than any other value.
+			low, high := int64(0), int64(time.Second-1)
+			if seconds == constraint.Min.Unix() {
+				low = int64(constraint.Min.Nanosecond())
+			}
+			if seconds == constraint.Max.Unix() {
+				high = int64(constraint.Max.Nanosecond())
+			}
+			nanos := low + parts.Nanos%(high-low+1)
+
+			return reflect.ValueOf(time.Unix(seconds, nanos).In(parts.Location))
+		})
+
+		return Struct(map[string]arbitrary.Generator{
+			"Seconds": Int64(constraints.Int64{
+				Min: constraint.Min.Unix() - anchor,
+				Max: constraint.Max.Unix() - anchor,
+			}),
+			"Nanos": Int64(constraints.Int64{
+				Min: 0,
+				Max: int64(time.Second - 1),
+			}),
+			"Location": Location(constraint.Locations...),
+		}).Map(mapper)(target, bias, r)
+	}
+}
+
+// Duration returns generator for time.Duration type. Range of duration values that can be
+// generated is defined by "limits" parameter. If no limits are provided default range
+// [math.MinInt64, math.MaxInt64] is used instead. Generated values are shrunk towards 0.
+// Error is returned if generator's target is not time.Duration or limits.Min is greater
+// than limits.Max.
+func Duration(limits ...constraints.Duration) arbitrary.Generator {
+	constraint := constraints.DurationDefault()
+	if len(limits) > 0 {
+		constraint = limits[0]
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf(time.Duration(0)) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Duration")
+		}
+
+		convertFn := func(in reflect.Value) reflect.Value {
+			return reflect.ValueOf(time.Duration(in.Int()))
+		}
+
+		return convert(Int64(constraints.Int64{
+			Min: int64(constraint.Min),
+			Max: int64(constraint.Max),
+		}), reflect.TypeOf(int64(0)), convertFn)(target, bias, r)
+	}
+}
+
+// Location returns generator for *time.Location type. Generated location is one of the
+// locations specified by "locations" parameter, and it is shrunk towards the first one.
+// If no locations are provided UTC is used. Error is returned if generator's target is
+// not *time.Location or any of the locations is nil.
+func Location(locations ...*time.Location) arbitrary.Generator {
+	if len(locations) == 0 {
+		locations = []*time.Location{time.UTC}
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf(time.UTC) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Location")
+		}
+		for index, location := range locations {
+			if location == nil {
+				return arbitrary.Arbitrary{}, fmt.Errorf("%w. Location at index %d is nil", arbitrary.ErrorInvalidConfig, index)
+			}
+		}
+
+		convertFn := func(in reflect.Value) reflect.Value {
+			return reflect.ValueOf(locations[in.Uint()])
+		}
+
+		return convert(Uint64(constraints.Uint64{
+			Min: 0,
+			Max: uint64(len(locations) - 1),
+		}), reflect.TypeOf(uint64(0)), convertFn)(target, bias, r)
+	}
+}
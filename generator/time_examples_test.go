@@ -0,0 +1,72 @@
+package generator_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Time() generator with constraints for generation of
+// time.Time values. Constraints define the range of generated values and locations the
+// generated values can be in.
+func ExampleTime() {
+	streamer := generator.Streamer(
+		func(t time.Time) {
+			fmt.Println(t.Format(time.RFC3339Nano))
+		},
+		generator.Time(constraints.Time{
+			Min: time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC),
+			Max: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			Locations: []*time.Location{
+				time.UTC,
+				time.FixedZone("UTC+1", 3600),
+			},
+		}),
+	)
+
+	if err := generator.Stream(0, 10, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// 2024-02-28T06:49:25.669827058Z
+	// 2024-02-28T14:38:24.428553046+01:00
+	// 2024-02-29T06:33:41.457021206Z
+	// 2024-02-29T18:01:55.65239648+01:00
+	// 2024-02-29T13:51:13.181734651Z
+	// 2024-02-29T06:02:16.949953021Z
+	// 2024-02-28T07:49:57.141136839+01:00
+	// 2024-02-29T09:28:44.151441917Z
+	// 2024-02-29T20:28:05.731977107+01:00
+	// 2024-02-28T03:40:09.016579444+01:00
+}
+
+// This example demonstrates how to use Duration() generator with constraints for generation
+// of time.Duration values.
+func ExampleDuration() {
+	streamer := generator.Streamer(
+		func(d time.Duration) {
+			fmt.Println(d)
+		},
+		generator.Duration(constraints.Duration{
+			Min: -time.Hour,
+			Max: time.Hour,
+		}),
+	)
+
+	if err := generator.Stream(0, 10, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// -42m46.912786418s
+	// 19m7.582785567s
+	// 8m32.34057416s
+	// 29m32.142505457s
+	// 13m35.129275845s
+	// 36m11.149958612s
+	// -6m20.89228803s
+	// -25m1.725594705s
+	// -22m22.255047327s
+	// -5m54.211013117s
+}
@@ -0,0 +1,196 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestTime(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(int64) {},
+				Time(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(time.Time) {},
+				Time(constraints.Time{
+					Min: time.Unix(100, 0),
+					Max: time.Unix(0, 0),
+				}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"WithinRange": func(t *testing.T) {
+			limits := constraints.Time{
+				Min: time.Date(2020, time.February, 28, 0, 0, 0, 0, time.UTC),
+				Max: time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC),
+				Locations: []*time.Location{
+					time.FixedZone("CET", 3600),
+					time.FixedZone("EST", -5*3600),
+				},
+			}
+
+			err := Stream(0, 100, Streamer(
+				func(tm time.Time) {
+					switch {
+					case tm.Before(limits.Min) || tm.After(limits.Max):
+						t.Fatalf("Time %s is not within limits: [%s, %s]", tm, limits.Min, limits.Max)
+					case tm.Location() != limits.Locations[0] && tm.Location() != limits.Locations[1]:
+						t.Fatalf("Unexpected location: %s", tm.Location())
+					case tm != tm.Round(0):
+						t.Fatalf("Time %s has monotonic clock reading", tm)
+					}
+				},
+				Time(limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"LimitsNotFavoured": func(t *testing.T) {
+			limits := constraints.Time{
+				Min: time.Unix(10, 100),
+				Max: time.Unix(11, 200),
+			}
+
+			count := 0
+			err := Stream(0, 100, Streamer(
+				func(in time.Time) {
+					if in.Before(limits.Min) || in.After(limits.Max) {
+						t.Fatalf("Generated time %s is not within limits", in)
+					}
+					if in.Equal(limits.Min) || in.Equal(limits.Max) {
+						count++
+					}
+				},
+				Time(limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if count > 10 {
+				t.Fatalf("Limits are generated %d times out of 100", count)
+			}
+		},
+		"ShrinkTowardsAnchor": func(t *testing.T) {
+			anchor := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+			shrunk := shrink(t, Time(constraints.Time{
+				Min:    time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Max:    time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Anchor: anchor,
+			}), reflect.TypeOf(time.Time{}), func(reflect.Value) bool {
+				return true
+			})
+
+			if tm := shrunk.Interface().(time.Time); !tm.Equal(anchor) {
+				t.Fatalf("Expected time to be shrunk to: %s. Got: %s", anchor, tm)
+			}
+		},
+		"Any": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(time.Time, time.Duration, *time.Location) {},
+				Any(),
+				Any(),
+				Any(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
+
+func TestDuration(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(int64) {},
+				Duration(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidConstraints": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(time.Duration) {},
+				Duration(constraints.Duration{Min: time.Second, Max: 0}),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConstraints) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConstraints)
+			}
+		},
+		"WithinRange": func(t *testing.T) {
+			limits := constraints.Duration{Min: -time.Minute, Max: time.Hour}
+			err := Stream(0, 100, Streamer(
+				func(d time.Duration) {
+					if d < limits.Min || d > limits.Max {
+						t.Fatalf("Duration %s is not within limits: [%s, %s]", d, limits.Min, limits.Max)
+					}
+				},
+				Duration(limits),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
+
+func TestLocation(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(time.Location) {},
+				Location(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"NilLocation": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(*time.Location) {},
+				Location(time.UTC, nil),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidConfig) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidConfig)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -0,0 +1,32 @@
+package generator
+
+import (
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// Tuple returns generator for struct types, where struct is used as a tuple. Generator for
+// each struct field is specified positionally by "generators" parameter: first generator is
+// used for the first field, second for the second field and so on. Tuple is shrunk the same
+// way as struct generated by [Struct] generator. Error is returned if generator's target is
+// not a struct, number of generators doesn't match the number of struct's fields, or any of
+// the generators returns an error.
+func Tuple(generators ...arbitrary.Generator) arbitrary.Generator {
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target.Kind() != reflect.Struct {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Tuple")
+		}
+		if target.NumField() != len(generators) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidCollectionSize(target.NumField(), len(generators))
+		}
+
+		fields := make(map[string]arbitrary.Generator, len(generators))
+		for index, generator := range generators {
+			fields[target.Field(index).Name] = generator
+		}
+
+		return Struct(fields)(target, bias, r)
+	}
+}
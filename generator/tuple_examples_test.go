@@ -0,0 +1,37 @@
+package generator_test
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use Tuple() generator for generation of struct values,
+// where generators for struct fields are specified by their position.
+func ExampleTuple() {
+	type Pair struct {
+		Key   string
+		Value int
+	}
+
+	streamer := generator.Streamer(
+		func(p Pair) {
+			fmt.Printf("%+v\n", p)
+		},
+		generator.Tuple(
+			generator.StringMatching("[a-z]{3}"),
+			generator.Int(constraints.Int{Min: 0, Max: 10}),
+		),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// {Key:wqq Value:6}
+	// {Key:uda Value:3}
+	// {Key:ivh Value:2}
+	// {Key:psi Value:5}
+	// {Key:jju Value:9}
+}
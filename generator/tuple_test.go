@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestTuple(t *testing.T) {
+	type pair struct {
+		First  int
+		second string
+	}
+
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func([]int) {},
+				Tuple(Int()),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"InvalidNumberOfGenerators": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(pair) {},
+				Tuple(Int()),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidCollectionSize) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidCollectionSize)
+			}
+		},
+		"PositionalGenerators": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(p pair) {
+					if p.First < 0 || p.First > 10 {
+						t.Fatalf("First element %d is not within limits", p.First)
+					}
+					if p.second != "constant" {
+						t.Fatalf("Unexpected second element: %s", p.second)
+					}
+				},
+				Tuple(
+					Int(constraints.Int{Min: 0, Max: 10}),
+					Constant("constant"),
+				),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
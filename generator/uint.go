@@ -50,13 +50,13 @@ func Uint32(limits ...constraints.Uint32) arbitrary.Generator {
 			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Uint32")
 		}
 
-		mapper := arbitrary.Mapper(reflect.TypeOf(uint64(0)), target, func(in reflect.Value) reflect.Value {
-			return reflect.ValueOf(uint32(in.Uint())).Convert(target)
-		})
-		return Uint64(constraints.Uint64{
+		convertFn := func(in reflect.Value) reflect.Value {
+			return in.Convert(target)
+		}
+		return convert(Uint64(constraints.Uint64{
 			Min: uint64(constraint.Min),
 			Max: uint64(constraint.Max),
-		}).Map(mapper)(target, bias, r)
+		}), reflect.TypeOf(uint64(0)), convertFn)(target, bias, r)
 	}
 
 }
@@ -75,13 +75,13 @@ func Uint16(limits ...constraints.Uint16) arbitrary.Generator {
 			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Uint16")
 		}
 
-		mapper := arbitrary.Mapper(reflect.TypeOf(uint64(0)), target, func(in reflect.Value) reflect.Value {
-			return reflect.ValueOf(uint16(in.Uint())).Convert(target)
-		})
-		return Uint64(constraints.Uint64{
+		convertFn := func(in reflect.Value) reflect.Value {
+			return in.Convert(target)
+		}
+		return convert(Uint64(constraints.Uint64{
 			Min: uint64(constraint.Min),
 			Max: uint64(constraint.Max),
-		}).Map(mapper)(target, bias, r)
+		}), reflect.TypeOf(uint64(0)), convertFn)(target, bias, r)
 	}
 }
 
@@ -98,13 +98,13 @@ func Uint8(limits ...constraints.Uint8) arbitrary.Generator {
 		if target.Kind() != reflect.Uint8 {
 			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Uint8")
 		}
-		mapper := arbitrary.Mapper(reflect.TypeOf(uint64(0)), target, func(in reflect.Value) reflect.Value {
-			return reflect.ValueOf(uint8(in.Uint())).Convert(target)
-		})
-		return Uint64(constraints.Uint64{
+		convertFn := func(in reflect.Value) reflect.Value {
+			return in.Convert(target)
+		}
+		return convert(Uint64(constraints.Uint64{
 			Min: uint64(constraint.Min),
 			Max: uint64(constraint.Max),
-		}).Map(mapper)(target, bias, r)
+		}), reflect.TypeOf(uint64(0)), convertFn)(target, bias, r)
 	}
 }
 
@@ -122,13 +122,13 @@ func Uint(limits ...constraints.Uint) arbitrary.Generator {
 			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "Uint")
 		}
 
-		mapper := arbitrary.Mapper(reflect.TypeOf(uint64(0)), target, func(in reflect.Value) reflect.Value {
-			return reflect.ValueOf(uint(in.Uint())).Convert(target)
-		})
+		convertFn := func(in reflect.Value) reflect.Value {
+			return in.Convert(target)
+		}
 
-		return Uint64(constraints.Uint64{
+		return convert(Uint64(constraints.Uint64{
 			Min: uint64(constraint.Min),
 			Max: uint64(constraint.Max),
-		}).Map(mapper)(target, bias, r)
+		}), reflect.TypeOf(uint64(0)), convertFn)(target, bias, r)
 	}
 }
@@ -0,0 +1,52 @@
+package generator
+
+import (
+	"net/url"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// urlParts is an intermediate representation of url.URL value.
+type urlParts struct {
+	Scheme   string
+	Host     string
+	Path     string
+	Query    string
+	Fragment string
+}
+
+// URL returns generator for *url.URL type. Generated URLs are absolute URLs that consist
+// of a scheme, a host with optional port, and an optional path, query and fragment. Each
+// part of the URL is generated with [StringMatching] generator and is shrunk accordingly:
+// scheme towards http, host towards single character host and path, query and fragment
+// towards empty ones. Error is returned if generator's target is not *url.URL.
+func URL() arbitrary.Generator {
+	parts := Struct(map[string]arbitrary.Generator{
+		"Scheme":   StringMatching(`(http|https|ftp|[a-z][a-z0-9+.-]{0,7})`),
+		"Host":     StringMatching(`[a-z0-9]([a-z0-9-]{0,8}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,8}[a-z0-9])?){0,3}(:[1-9][0-9]{0,3})?`),
+		"Path":     StringMatching(`(/[A-Za-z0-9._~-]{0,8}){0,4}`),
+		"Query":    StringMatching(`([a-z]{1,5}=[A-Za-z0-9]{0,5}(&[a-z]{1,5}=[A-Za-z0-9]{0,5}){0,3})?`),
+		"Fragment": StringMatching(`[A-Za-z0-9]{0,8}`),
+	})
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		if target != reflect.TypeOf((*url.URL)(nil)) {
+			return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(target, "URL")
+		}
+
+		mapper := arbitrary.Mapper(reflect.TypeOf(urlParts{}), target, func(in reflect.Value) reflect.Value {
+			parts := in.Interface().(urlParts)
+			return reflect.ValueOf(&url.URL{
+				Scheme:   parts.Scheme,
+				Host:     parts.Host,
+				Path:     parts.Path,
+				RawQuery: parts.Query,
+				Fragment: parts.Fragment,
+			})
+		})
+
+		return parts.Map(mapper)(target, bias, r)
+	}
+}
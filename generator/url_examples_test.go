@@ -0,0 +1,28 @@
+package generator_test
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/steffnova/go-check/generator"
+)
+
+// This example demonstrates how to use URL() generator for generation of *url.URL values.
+func ExampleURL() {
+	streamer := generator.Streamer(
+		func(u *url.URL) {
+			fmt.Println(u)
+		},
+		generator.URL(),
+	)
+
+	if err := generator.Stream(0, 5, streamer); err != nil {
+		panic(err)
+	}
+	// Output:
+	// ftp://glj2-tr.h.sbeu4it89.9h/srCS//LRDnmW?weuin=uJCvP&l=&xnjin=C&a=ei
+	// a://4h.h:8865/NiNrmR/K/0hJA-5/8_9GQYHb?iq=b6&gjpwk=&e=c0g6#g
+	// http://x.w.hb6jl0:2512#aGPT1
+	// ftp://c/6gEkw/dxJw#pMR
+	// w://kyvedp.kbubn1k6.f/C.MJL/yuFQg2d/oXv0Qz/C
+}
@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+func TestURL(t *testing.T) {
+	testCases := map[string]func(*testing.T){
+		"InvalidTarget": func(t *testing.T) {
+			err := Stream(0, 10, Streamer(
+				func(url.URL) {},
+				URL(),
+			))
+
+			if !errors.Is(err, arbitrary.ErrorInvalidTarget) {
+				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
+			}
+		},
+		"RoundTrip": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(u *url.URL) {
+					parsed, err := url.Parse(u.String())
+					if err != nil {
+						t.Fatalf("Failed to parse URL %s: %s", u, err)
+					}
+					if !reflect.DeepEqual(parsed, u) {
+						t.Fatalf("Parsed URL %#v doesn't match generated URL %#v", parsed, u)
+					}
+				},
+				URL(),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			shrunk := shrink(t, URL(), reflect.TypeOf((*url.URL)(nil)), func(reflect.Value) bool {
+				return true
+			})
+
+			if u := shrunk.Interface().(*url.URL); u.String() != "http://0" {
+				t.Fatalf("Expected URL to be shrunk to: http://0. Got: %s", u)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
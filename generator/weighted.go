@@ -7,60 +7,116 @@ import (
 
 	"github.com/steffnova/go-check/arbitrary"
 	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/shrinker"
 )
 
 // Weighted returns one of the generators based on their weight. Weights and
 // generators are specified by "weights" and "generators" parameters respectively.
 // Number of weights and generators must be the same and greater than 0. Total sum
-// of all weights can't exceed math.Uint64. Error is returned if number of "weights"
-// and "generators" is invalid, sum of all weights exceed math.Uint64, weight value
-// is lower than 1, or weighted generators returns an error.
+// of all weights can't exceed math.Uint64. Only the chosen generator is used for
+// generating a value. Generators that precede the chosen one are considered simpler,
+// and they are used only during shrinking, when shrinker tries to replace generated
+// value with the one generated by simpler generators, before shrinking the value
+// itself. Simpler values are generated with random number generator split from the
+// original one when value is generated, and seeded for each simpler generator with a
+// seed drawn at the same time, thus they are the same each time shrinking is done
+// regardless of random numbers drawn since. Error is returned if number of "weights" and "generators" is
+// invalid, sum of all weights exceed math.Uint64, weight value is lower than 1, or
+// chosen generator returns an error.
 func Weighted(weights []uint64, generators ...arbitrary.Generator) arbitrary.Generator {
+	choose, err := weighted(weights, generators)
+	if err != nil {
+		return Invalid(err)
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		index := choose(r)
+
+		chosen, err := generators[index](target, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, fmt.Errorf("failed to use generator with index: %d. %w", index, err)
+		}
+
+		// Simpler alternatives are generated with random number generator that is seeded for
+		// each of them with a seed drawn at generation, so that shrinking is deterministic.
+		random, seed := arbitrary.Random(nil), int64(0)
+		if index > 0 {
+			random, seed = r.Split(), int64(r.Uint64(constraints.Uint64Default()))
+		}
+		alternative := func(index int) (arbitrary.Arbitrary, error) {
+			random.Seed(seed + int64(index))
+			return generators[index](target, bias, random)
+		}
+
+		return arbitrary.Arbitrary{
+			Value:      chosen.Value,
+			Precursors: arbitrary.Arbitraries{chosen},
+			Shrinker:   shrinker.Choice(index, alternative),
+			Table:      chosen.Table,
+		}, nil
+	}
+}
+
+// branch returns one of the generators based on their weight, similar to [Weighted].
+// Unlike Weighted, generated value is shrunk only by the chosen generator. It's used
+// for splitting a range of values into branches (e.g. negative and positive numbers)
+// that are not simpler than one another.
+func branch(weights []uint64, generators ...arbitrary.Generator) arbitrary.Generator {
+	choose, err := weighted(weights, generators)
+	if err != nil {
+		return Invalid(err)
+	}
+
+	return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
+		index := choose(r)
+
+		arb, err := generators[index](target, bias, r)
+		if err != nil {
+			return arbitrary.Arbitrary{}, fmt.Errorf("failed to use generator with index: %d. %w", index, err)
+		}
+		return arb, nil
+	}
+}
+
+// weighted validates "weights" and "generators" and returns a function that chooses an
+// index of one of the generators based on their weight.
+func weighted(weights []uint64, generators []arbitrary.Generator) (func(arbitrary.Random) int, error) {
 	switch {
 	case len(weights) == 0:
-		return Invalid(fmt.Errorf("%w. Number of weights can't be 0", arbitrary.ErrorInvalidConfig))
+		return nil, fmt.Errorf("%w. Number of weights can't be 0", arbitrary.ErrorInvalidConfig)
 	case len(generators) == 0:
-		return Invalid(fmt.Errorf("%w. Number of generators can't be 0", arbitrary.ErrorInvalidConfig))
+		return nil, fmt.Errorf("%w. Number of generators can't be 0", arbitrary.ErrorInvalidConfig)
 	case len(weights) != len(generators):
-		return Invalid(fmt.Errorf("%w. Number of weights and generators must be the same", arbitrary.ErrorInvalidConfig))
-	default:
-		return func(target reflect.Type, bias constraints.Bias, r arbitrary.Random) (arbitrary.Arbitrary, error) {
-			totalWeight := uint64(0)
-
-			weightsIndex := make([]uint64, len(generators))
-			arbitraries := make([]arbitrary.Arbitrary, len(generators))
+		return nil, fmt.Errorf("%w. Number of weights and generators must be the same", arbitrary.ErrorInvalidConfig)
+	}
 
-			for index, generator := range generators {
-				if weights[index] < 1 {
-					return arbitrary.Arbitrary{}, fmt.Errorf("%w. Weight can't be less than 1: weights[%d] %d", arbitrary.ErrorInvalidConfig, index, weights[index])
-				}
-				gen, err := generator(target, bias, r)
-				if err != nil {
-					return arbitrary.Arbitrary{}, fmt.Errorf("failed to instantiate generator with index: %d. %w", index, err)
-				}
+	// Total weight is reduced by 1, so that sum of all weights can be equal to
+	// math.MaxUint64 + 1 (e.g. when choosing between negative and positive int64).
+	totalWeight := uint64(0)
+	weightsIndex := make([]uint64, len(weights))
+	for index, weight := range weights {
+		if weight < 1 {
+			return nil, fmt.Errorf("%w. Weight can't be less than 1: weights[%d] %d", arbitrary.ErrorInvalidConfig, index, weight)
+		}
 
-				prevWeight := totalWeight
-				totalWeight += weights[index]
-				if index == 0 {
-					totalWeight -= 1
-				}
-				if prevWeight > totalWeight {
-					return arbitrary.Arbitrary{}, fmt.Errorf("%w. Total weght overflow. (sum of all weights can't exceed %d)", arbitrary.ErrorInvalidConfig, uint(math.MaxUint64))
-				}
-				weightsIndex[index] = totalWeight
-				arbitraries[index] = gen
-			}
+		prevWeight := totalWeight
+		totalWeight += weight
+		if index == 0 {
+			totalWeight -= 1
+		}
+		if prevWeight > totalWeight {
+			return nil, fmt.Errorf("%w. Total weght overflow. (sum of all weights can't exceed %d)", arbitrary.ErrorInvalidConfig, uint(math.MaxUint64))
+		}
+		weightsIndex[index] = totalWeight
+	}
 
-			x := r.Uint64(constraints.Uint64{Min: 0, Max: uint64(totalWeight)})
-			arb := arbitraries[0]
-			for index, weight := range weightsIndex {
-				if weight >= x {
-					arb = arbitraries[index]
-					break
-				}
+	return func(r arbitrary.Random) int {
+		x := r.Uint64(constraints.Uint64{Min: 0, Max: totalWeight})
+		for index, weight := range weightsIndex {
+			if weight >= x {
+				return index
 			}
-
-			return arb, nil
 		}
-	}
+		return 0
+	}, nil
 }
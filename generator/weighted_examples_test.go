@@ -33,14 +33,14 @@ func ExampleWeighted() {
 		panic(err)
 	}
 	// Output:
-	// 4518808235179270133
-	// <nil>
+	// 1002466900374765554
 	// 7861855757602232086
-	// 5254077479683016640
-	// 11116474692239114024
 	// 15398783846516204029
-	// 14677457169740829639
-	// 9472434474353809100
-	// 2396012503939351775
-	// 3877601997538530707
+	// 16172318933975836041
+	// <nil>
+	// 5437755695196026163
+	// 17671690107166572221
+	// 18153157278916413664
+	// <nil>
+	// <nil>
 }
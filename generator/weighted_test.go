@@ -3,9 +3,11 @@ package generator
 import (
 	"errors"
 	"math"
+	"reflect"
 	"testing"
 
 	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
 )
 
 func TestWeighted(t *testing.T) {
@@ -70,6 +72,101 @@ func TestWeighted(t *testing.T) {
 				t.Fatalf("Expected error: '%s'", arbitrary.ErrorInvalidTarget)
 			}
 		},
+		"OnlyChosenGenerator": func(t *testing.T) {
+			err := Stream(0, 100, Streamer(
+				func(int) {},
+				Weighted([]uint64{1, math.MaxUint32}, Bool(), Int()),
+			))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		},
+		"ShrinkToSimplerGenerator": func(t *testing.T) {
+			generator := Weighted(
+				[]uint64{1, math.MaxUint32},
+				Int(constraints.Int{Min: 0, Max: 10}),
+				Int(constraints.Int{Min: 1000, Max: 2000}),
+			)
+
+			shrunk := shrink(t, generator, reflect.TypeOf(0), func(v reflect.Value) bool {
+				return v.Int() >= 1000
+			})
+			if shrunk.Int() != 1000 {
+				t.Fatalf("Expected value to be shrunk to 1000. Got: %d", shrunk.Int())
+			}
+
+			shrunk = shrink(t, generator, reflect.TypeOf(0), func(v reflect.Value) bool {
+				return v.Int() >= 0
+			})
+			if shrunk.Int() != 0 {
+				t.Fatalf("Expected value to be shrunk to 0 by simpler generator. Got: %d", shrunk.Int())
+			}
+		},
+		"ShrinkSkipsInvalidTarget": func(t *testing.T) {
+			shrunk := shrink(t, Weighted([]uint64{1, math.MaxUint32}, Bool(), Int()), reflect.TypeOf(0), func(reflect.Value) bool {
+				return true
+			})
+			if shrunk.Int() != 0 {
+				t.Fatalf("Expected value to be shrunk to 0. Got: %d", shrunk.Int())
+			}
+		},
+		"ShrinkSkipsFailingAlternative": func(t *testing.T) {
+			generator := Weighted(
+				[]uint64{1, math.MaxUint32},
+				Int(constraints.Int{Min: 10, Max: 0}),
+				Int(constraints.Int{Min: 1000, Max: 2000}),
+			)
+
+			shrunk := shrink(t, generator, reflect.TypeOf(0), func(reflect.Value) bool {
+				return true
+			})
+			if shrunk.Int() != 1000 {
+				t.Fatalf("Expected value to be shrunk to 1000. Got: %d", shrunk.Int())
+			}
+		},
+		"DrawsOnlyChoice": func(t *testing.T) {
+			draws := func(generator arbitrary.Generator) int {
+				recorder := arbitrary.NewRandomRecorder(arbitrary.NewRandomNumber(0))
+				if _, err := generator(reflect.TypeOf(0), constraints.Bias{Size: 100, Scaling: 100}, recorder); err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return len(recorder.Draws())
+			}
+
+			if weighted, chosen := draws(Weighted([]uint64{1}, Int())), draws(Int()); weighted != chosen+1 {
+				t.Fatalf("Expected Weighted to draw only the choice (%d draws). Got: %d", chosen+1, weighted)
+			}
+		},
+		"DeterministicAlternatives": func(t *testing.T) {
+			generator := Weighted([]uint64{1, 1, math.MaxUint32}, Int(), Int(), Int())
+
+			// shrinks returns values of the shrinks, while "draws" random numbers are drawn
+			// from generator's random number generator between the shrinks.
+			shrinks := func(draws int) []int64 {
+				r := arbitrary.NewRandomNumber(0)
+				arb, err := generator(reflect.TypeOf(0), constraints.Bias{Size: 100, Scaling: 100}, r)
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+
+				values := []int64{}
+				for arb.Shrinker != nil {
+					if arb, err = arb.Shrinker(arb, false); err != nil {
+						t.Fatalf("Unexpected error: %s", err)
+					}
+					values = append(values, arb.Value.Int())
+					for i := 0; i < draws; i++ {
+						r.Uint64(constraints.Uint64Default())
+					}
+				}
+				return values
+			}
+
+			if first, second := shrinks(0), shrinks(5); !reflect.DeepEqual(first, second) {
+				t.Fatalf("Expected the same shrinks. Got: %v and %v", first, second)
+			}
+		},
 	}
 
 	for name, testCase := range testCases {
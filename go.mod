@@ -1,3 +1,3 @@
 module github.com/steffnova/go-check
 
-go 1.20
+go 1.22
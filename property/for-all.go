@@ -0,0 +1,117 @@
+package property
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/gen"
+)
+
+// ForAll1 defines a property whose predicate has one input parameter, generated by "a"
+// generator. Unlike [Define], types of generators and predicate are checked at compile time,
+// and predicate is called directly instead of being called through reflection.
+func ForAll1[A any](a gen.Gen[A], predicate func(A) error) Property {
+	return forAll(func(in *values) error {
+		inA := value[A](in, 0)
+		if in.err != nil {
+			return in.err
+		}
+		return predicate(inA)
+	}, []reflect.Type{a.Target()}, a.Generator())
+}
+
+// ForAll2 defines a property whose predicate has two input parameters. See [ForAll1].
+func ForAll2[A, B any](a gen.Gen[A], b gen.Gen[B], predicate func(A, B) error) Property {
+	return forAll(func(in *values) error {
+		inA, inB := value[A](in, 0), value[B](in, 1)
+		if in.err != nil {
+			return in.err
+		}
+		return predicate(inA, inB)
+	}, []reflect.Type{a.Target(), b.Target()}, a.Generator(), b.Generator())
+}
+
+// ForAll3 defines a property whose predicate has three input parameters. See [ForAll1].
+func ForAll3[A, B, C any](a gen.Gen[A], b gen.Gen[B], c gen.Gen[C], predicate func(A, B, C) error) Property {
+	return forAll(func(in *values) error {
+		inA, inB, inC := value[A](in, 0), value[B](in, 1), value[C](in, 2)
+		if in.err != nil {
+			return in.err
+		}
+		return predicate(inA, inB, inC)
+	}, []reflect.Type{a.Target(), b.Target(), c.Target()}, a.Generator(), b.Generator(), c.Generator())
+}
+
+// ForAll4 defines a property whose predicate has four input parameters. See [ForAll1].
+func ForAll4[A, B, C, D any](a gen.Gen[A], b gen.Gen[B], c gen.Gen[C], d gen.Gen[D], predicate func(A, B, C, D) error) Property {
+	return forAll(func(in *values) error {
+		inA, inB, inC, inD := value[A](in, 0), value[B](in, 1), value[C](in, 2), value[D](in, 3)
+		if in.err != nil {
+			return in.err
+		}
+		return predicate(inA, inB, inC, inD)
+	}, []reflect.Type{a.Target(), b.Target(), c.Target(), d.Target()}, a.Generator(), b.Generator(), c.Generator(), d.Generator())
+}
+
+// ForAll5 defines a property whose predicate has five input parameters. See [ForAll1].
+func ForAll5[A, B, C, D, E any](a gen.Gen[A], b gen.Gen[B], c gen.Gen[C], d gen.Gen[D], e gen.Gen[E], predicate func(A, B, C, D, E) error) Property {
+	return forAll(func(in *values) error {
+		inA, inB, inC, inD, inE := value[A](in, 0), value[B](in, 1), value[C](in, 2), value[D](in, 3), value[E](in, 4)
+		if in.err != nil {
+			return in.err
+		}
+		return predicate(inA, inB, inC, inD, inE)
+	}, []reflect.Type{a.Target(), b.Target(), c.Target(), d.Target(), e.Target()}, a.Generator(), b.Generator(), c.Generator(), d.Generator(), e.Generator())
+}
+
+// ForAll6 defines a property whose predicate has six input parameters. See [ForAll1].
+func ForAll6[A, B, C, D, E, F any](a gen.Gen[A], b gen.Gen[B], c gen.Gen[C], d gen.Gen[D], e gen.Gen[E], f gen.Gen[F], predicate func(A, B, C, D, E, F) error) Property {
+	return forAll(func(in *values) error {
+		inA, inB, inC, inD, inE, inF := value[A](in, 0), value[B](in, 1), value[C](in, 2), value[D](in, 3), value[E](in, 4), value[F](in, 5)
+		if in.err != nil {
+			return in.err
+		}
+		return predicate(inA, inB, inC, inD, inE, inF)
+	}, []reflect.Type{a.Target(), b.Target(), c.Target(), d.Target(), e.Target(), f.Target()}, a.Generator(), b.Generator(), c.Generator(), d.Generator(), e.Generator(), f.Generator())
+}
+
+// forAll defines a property using typed runner, whose input parameters are of types
+// specified by "targets" and are generated by "generators".
+func forAll(run func(*values) error, targets []reflect.Type, generators ...arbitrary.Generator) Property {
+	return Define(Inputs(generators...), func() ([]reflect.Type, runner) {
+		return targets, func(arbs arbitrary.Arbitraries) error {
+			if len(arbs) != len(targets) {
+				return fmt.Errorf("number of predicate input parameters (%d) doesn't match number of arbs (%d)", len(targets), len(arbs))
+			}
+			return run(&values{arbs: arbs})
+		}
+	})
+}
+
+// values are arbitraries whose values are passed to the predicate. Err holds the first
+// error of converting arbitrary's value to predicate's input parameter.
+type values struct {
+	arbs arbitrary.Arbitraries
+	err  error
+}
+
+// value returns value of the arbitrary at "index" as a value of type T. Zero value of T is
+// returned for invalid values and nil interfaces. If value is not of type T, zero value is
+// returned and error is stored in values.
+func value[T any](in *values, index int) T {
+	var result T
+	arb := in.arbs[index]
+	switch {
+	case in.err != nil, !arb.Value.IsValid():
+		return result
+	case arb.Value.Kind() == reflect.Interface && arb.Value.IsNil():
+		return result
+	}
+
+	result, ok := arb.Value.Interface().(T)
+	if !ok {
+		in.err = fmt.Errorf("%w. Value of type %s can't be used as predicate's input parameter %d of type %s", ErrorPredicate, arb.Value.Type(), index, reflect.TypeOf(&result).Elem())
+	}
+	return result
+}
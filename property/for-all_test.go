@@ -0,0 +1,113 @@
+package property
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/gen"
+	"github.com/steffnova/go-check/generator"
+)
+
+func TestForAll(t *testing.T) {
+	r := arbitrary.RandomNumber{Rand: rand.New(rand.NewSource(0))}
+	ints := gen.From[int](generator.Int(constraints.Int{Min: 0, Max: 100}))
+
+	testCases := map[string]func(*testing.T){
+		"PropertyPass": func(t *testing.T) {
+			property := ForAll6(ints, gen.Of[string](), gen.Of[bool](), gen.Of[[]uint8](), gen.Of[*int](), gen.Of[map[int8]bool](),
+				func(n int, s string, b bool, bytes []uint8, ptr *int, m map[int8]bool) error {
+					if n < 0 || n > 100 {
+						return fmt.Errorf("invalid value: %d", n)
+					}
+					return nil
+				},
+			)
+
+			details, err := property(r, constraints.Bias{Size: 100, Scaling: 1})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if details.FailureReason != nil {
+				t.Fatalf("Unexpected failure: %s", details.FailureReason)
+			}
+		},
+		"PropertyFailed": func(t *testing.T) {
+			property := ForAll2(ints, ints, func(x, y int) error {
+				if x+y > 10 {
+					return fmt.Errorf("sum is too big")
+				}
+				return nil
+			})
+
+			details, err := property(r, constraints.Bias{Size: 100, Scaling: 1})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if details.FailureReason == nil {
+				t.Fatalf("Expected property to fail")
+			}
+
+			x, y := details.FailureInput[0].Value.Int(), details.FailureInput[1].Value.Int()
+			if x+y != 11 {
+				t.Fatalf("Expected inputs to be shrunk to sum of 11. Got: %d, %d", x, y)
+			}
+		},
+		"NilInterface": func(t *testing.T) {
+			property := ForAll1(gen.From[error](generator.Nil()), func(err error) error {
+				if err != nil {
+					return fmt.Errorf("expected nil error. Got: %s", err)
+				}
+				return nil
+			})
+
+			details, err := property(r, constraints.Bias{Size: 100, Scaling: 1})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if details.FailureReason != nil {
+				t.Fatalf("Unexpected failure: %s", details.FailureReason)
+			}
+		},
+		"ValueTypeMismatch": func(t *testing.T) {
+			shrinks := 0
+			mismatched := gen.From[string](func(reflect.Type, constraints.Bias, arbitrary.Random) (arbitrary.Arbitrary, error) {
+				return arbitrary.Arbitrary{
+					Value: reflect.ValueOf(5),
+					Shrinker: func(arb arbitrary.Arbitrary, _ bool) (arbitrary.Arbitrary, error) {
+						shrinks++
+						return arb, nil
+					},
+				}, nil
+			})
+
+			property := ForAll1(mismatched, func(string) error {
+				return nil
+			})
+
+			if _, err := property(r, constraints.Bias{Size: 100, Scaling: 1}); !errors.Is(err, ErrorPredicate) {
+				t.Fatalf("Expected error: %s. Got: %v", ErrorPredicate, err)
+			}
+			if shrinks != 0 {
+				t.Fatalf("Expected inputs not to be shrunk. Got %d shrinks", shrinks)
+			}
+		},
+		"GeneratorError": func(t *testing.T) {
+			property := ForAll1(gen.From[string](generator.Int()), func(string) error {
+				return nil
+			})
+
+			if _, err := property(r, constraints.Bias{Size: 100, Scaling: 1}); !errors.Is(err, ErrorInputs) {
+				t.Fatalf("Expected error: %s. Got: %s", ErrorInputs, err)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
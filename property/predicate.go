@@ -46,7 +46,12 @@ func Predicate(definition any) predicate {
 				return fmt.Errorf("number of predicate input parameters (%d) doesn't match number of arbs (%d)", predicateVal.Type().NumIn(), len(arbs))
 			}
 
-			output := predicateVal.Call(arbs.Values())
+			// Inputs that can't be reused, like fed channels, are created for each run
+			// and released once predicate returns.
+			done := make(chan struct{})
+			defer close(done)
+
+			output := predicateVal.Call(arbs.Feed(done))
 			if !output[0].IsZero() {
 				return output[0].Interface().(error)
 			}
@@ -1,6 +1,7 @@
 package property
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/steffnova/go-check/arbitrary"
@@ -11,6 +12,7 @@ type Details struct {
 	NumberOfShrinks uint
 	FailureReason   error
 	FailureInput    arbitrary.Arbitraries
+	ShrinkPath      []bool // Predicate's outcome for each shrink, true if predicate failed
 }
 
 // Property is a function that takes [arbitrary.Random] and [constraints.Bias] parameters as inputs
@@ -41,6 +43,8 @@ type Property func(r arbitrary.Random, bias constraints.Bias) (Details, error)
 // inputs using shrinking. An error is returned when:
 //   - generator returns an error
 //   - predicate returns an error
+//   - predicate can't be called with generated inputs (error wraps [ErrorPredicate]), in
+//     which case inputs are not shrunk
 //   - shrinking process returns an error
 func Define(generator InputsGenerator, predicate predicate) Property {
 	return func(r arbitrary.Random, bias constraints.Bias) (Details, error) {
@@ -59,11 +63,15 @@ func Define(generator InputsGenerator, predicate predicate) Property {
 		}
 
 		predicateErr := runner(arbs)
-		if predicateErr == nil {
+		switch {
+		case errors.Is(predicateErr, ErrorPredicate):
+			return Details{}, predicateErr
+		case predicateErr == nil:
 			return Details{}, nil
 		}
 
 		numberOfShrinks := uint(0)
+		path := []bool{}
 
 		for shrinker != nil {
 			var shrinkingErr error
@@ -73,12 +81,17 @@ func Define(generator InputsGenerator, predicate predicate) Property {
 				return Details{}, shrinkingErr
 			}
 			predicateErr = runner(arbs)
+			if errors.Is(predicateErr, ErrorPredicate) {
+				return Details{}, predicateErr
+			}
+			path = append(path, predicateErr != nil)
 		}
 
 		return Details{
 			FailureInput:    arbs,
 			FailureReason:   predicateErr,
 			NumberOfShrinks: uint(numberOfShrinks),
+			ShrinkPath:      path,
 		}, nil
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/steffnova/go-check/arbitrary"
 	"github.com/steffnova/go-check/constraints"
@@ -100,6 +101,45 @@ func TestDefine(t *testing.T) {
 				t.Fatalf("Expected failure reason: %s. Got: %s", propertyError, details.FailureReason)
 			}
 		},
+		"FreshInputPerRun": func(t *testing.T) {
+			limits := constraints.ChanFeed{
+				Values:   constraints.Length{Min: 3, Max: 3},
+				MaxDelay: time.Millisecond,
+			}
+			received := []int{}
+			property := Define(
+				Inputs(
+					generator.ChanFeed(generator.Int(), limits),
+					generator.Int(constraints.Int{Min: 100, Max: 1000}),
+				),
+				Predicate(func(ch <-chan int, x int) error {
+					received = []int{}
+					for value := range ch {
+						received = append(received, value)
+					}
+					if len(received) == 3 {
+						return fmt.Errorf("property failed")
+					}
+					return nil
+				}))
+
+			r := arbitrary.RandomNumber{Rand: rand.New(rand.NewSource(0))}
+			details, err := property(r, constraints.Bias{Size: 100, Scaling: 1})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			// Channel is shrunk alongside x, so each predicate run must receive all of its values.
+			if details.FailureReason == nil {
+				t.Fatalf("Expected property to fail")
+			}
+			if x := details.FailureInput[1].Value.Int(); x != 100 {
+				t.Fatalf("Expected x to be shrunk to 100. Got: %d", x)
+			}
+			if !reflect.DeepEqual(received, []int{0, 0, 0}) {
+				t.Fatalf("Expected values to be shrunk to [0 0 0]. Got: %v", received)
+			}
+		},
 	}
 
 	for name, testCase := range testCases {
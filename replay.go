@@ -0,0 +1,118 @@
+package check
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// replayVersion is the version of replay token's encoding.
+const replayVersion = 3
+
+// replay holds everything needed to reproduce a single failed property run: bias used
+// by the run, values drawn from random number generator and predicate's outcomes for
+// each shrink.
+type replay struct {
+	bias   constraints.Bias
+	values []uint64
+	path   []bool
+}
+
+// newReplay returns replay of a failed property run that used bias, recorded draws and
+// followed shrink path.
+func newReplay(bias constraints.Bias, draws []arbitrary.Draw, path []bool) replay {
+	values := make([]uint64, len(draws))
+	for index, draw := range draws {
+		values[index] = draw.Value
+	}
+	return replay{bias: bias, values: values, path: path}
+}
+
+// diverged returns true if shrinking of the replayed run didn't follow the same path
+// as the failed run, which happens when predicate is not deterministic.
+func (r replay) diverged(path []bool) bool {
+	return !slices.Equal(r.path, path)
+}
+
+// String encodes replay into a token. Bias, number of drawn values and drawn values are
+// varint encoded, and followed by shrink path packed into bits. Result is encoded with
+// URL safe base64 encoding.
+func (r replay) String() string {
+	data := []byte{replayVersion}
+	data = binary.AppendVarint(data, int64(r.bias.Size))
+	data = binary.AppendVarint(data, int64(r.bias.Scaling))
+	data = binary.AppendUvarint(data, uint64(len(r.values)))
+	for _, value := range r.values {
+		data = binary.AppendUvarint(data, value)
+	}
+	data = binary.AppendUvarint(data, uint64(len(r.path)))
+
+	bits := make([]byte, (len(r.path)+7)/8)
+	for index, failed := range r.path {
+		if failed {
+			bits[index/8] |= 1 << (index % 8)
+		}
+	}
+
+	return base64.RawURLEncoding.EncodeToString(append(data, bits...))
+}
+
+// parseReplay decodes replay from a token created by replay.String. Error is
+// returned if token is invalid.
+func parseReplay(token string) (replay, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return replay{}, fmt.Errorf("invalid replay token. %w", err)
+	}
+	if len(data) == 0 || data[0] != replayVersion {
+		return replay{}, fmt.Errorf("invalid replay token. Unsupported version")
+	}
+
+	reader := bytes.NewReader(data[1:])
+	size, err := binary.ReadVarint(reader)
+	if err != nil {
+		return replay{}, fmt.Errorf("invalid replay token. %w", err)
+	}
+	scaling, err := binary.ReadVarint(reader)
+	if err != nil {
+		return replay{}, fmt.Errorf("invalid replay token. %w", err)
+	}
+	count, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return replay{}, fmt.Errorf("invalid replay token. %w", err)
+	}
+	if count > uint64(reader.Len()) {
+		return replay{}, fmt.Errorf("invalid replay token. Number of drawn values %d exceeds token's length", count)
+	}
+
+	r := replay{
+		bias:   constraints.Bias{Size: int(size), Scaling: int(scaling)},
+		values: make([]uint64, count),
+	}
+	for index := range r.values {
+		if r.values[index], err = binary.ReadUvarint(reader); err != nil {
+			return replay{}, fmt.Errorf("invalid replay token. %w", err)
+		}
+	}
+
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return replay{}, fmt.Errorf("invalid replay token. %w", err)
+	}
+	if uint64(reader.Len()) != (length+7)/8 {
+		return replay{}, fmt.Errorf("invalid replay token. Shrink path length %d doesn't match encoded path", length)
+	}
+
+	bits := data[len(data)-reader.Len():]
+	r.path = make([]bool, length)
+	for index := range r.path {
+		r.path[index] = bits[index/8]&(1<<(index%8)) != 0
+	}
+
+	return r, nil
+}
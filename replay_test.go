@@ -0,0 +1,124 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+	"github.com/steffnova/go-check/generator"
+	"github.com/steffnova/go-check/property"
+)
+
+func TestReplay(t *testing.T) {
+	// failure runs property with values drawn from random number generator seeded with 0,
+	// and returns replay of the first failed run.
+	failure := func(t *testing.T, p property.Property) (replay, property.Details) {
+		random := arbitrary.NewRandomNumber(0)
+		for i := 0; i < 100; i++ {
+			bias := constraints.Bias{Size: 100, Scaling: 100 - i}
+			recorder := arbitrary.NewRandomRecorder(random)
+
+			details, err := p(recorder, bias)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if details.FailureReason != nil {
+				return newReplay(bias, recorder.Draws(), details.ShrinkPath), details
+			}
+		}
+		t.Fatalf("Expected property to fail")
+		return replay{}, property.Details{}
+	}
+
+	testCases := map[string]func(*testing.T){
+		"EncodeDecode": func(t *testing.T) {
+			expected := replay{
+				bias:   constraints.Bias{Size: 100, Scaling: 42},
+				values: []uint64{0, 1, 1 << 63, 18446744073709551615},
+				path:   []bool{true, false, false, true, true, false, true, false, true},
+			}
+
+			decoded, err := parseReplay(expected.String())
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(decoded, expected) {
+				t.Fatalf("Expected decoded replay: %v. Got: %v", expected, decoded)
+			}
+		},
+		"InvalidToken": func(t *testing.T) {
+			valid := replay{values: []uint64{1}, path: []bool{true}}.String()
+			tokens := []string{
+				"not a token",
+				"AAAA",
+				valid[:len(valid)-2],
+				valid + "AA",
+			}
+			for _, token := range tokens {
+				if _, err := parseReplay(token); err == nil {
+					t.Fatalf("Expected error for token: %s", token)
+				}
+			}
+		},
+		"Compact": func(t *testing.T) {
+			// Function's outputs are drawn while it's called, and they are not part of the replay.
+			values := map[int]int{}
+			for _, calls := range []int{1, 1000} {
+				r, _ := failure(t, property.Define(
+					property.Inputs(generator.Func(generator.Int())),
+					property.Predicate(func(f func(int) int) error {
+						for n := 0; n < calls; n++ {
+							f(n)
+						}
+						return fmt.Errorf("property failed")
+					}),
+				))
+				values[calls] = len(r.values)
+			}
+
+			if values[1000] != values[1] {
+				t.Fatalf("Expected number of drawn values not to depend on function calls. Got: %d and %d", values[1], values[1000])
+			}
+		},
+		"ReproducesFailure": func(t *testing.T) {
+			p := property.Define(
+				property.Inputs(
+					generator.Slice(generator.Int()),
+					generator.Func(generator.Bool()),
+				),
+				property.Predicate(func(numbers []int, f func(int) bool) error {
+					for _, n := range numbers {
+						if n > 1000 && f(n) {
+							return fmt.Errorf("number %d is greater than 1000", n)
+						}
+					}
+					return nil
+				}),
+			)
+
+			failed, details := failure(t, p)
+			r, err := parseReplay(failed.String())
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			// Replay splits Randoms from a generator with different seed than the failed run.
+			replayed, err := p(arbitrary.NewRandomReplay(r.values, arbitrary.NewRandomNumber(1)), r.bias)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if replayed.FailureReason == nil || replayed.FailureReason.Error() != details.FailureReason.Error() {
+				t.Fatalf("Expected replayed failure: %s. Got: %v", details.FailureReason, replayed.FailureReason)
+			}
+			if r.diverged(replayed.ShrinkPath) {
+				t.Fatalf("Expected replayed shrinking to follow shrink path of the failed run")
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -0,0 +1,93 @@
+package shrinker
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// bytesState is the state of bytes shrinking. Last is the last value that falsified the
+// property. While size is greater than 0, chunk of bytes of that size at index is removed.
+// Once size drops to 0, byte at index is minimized, and low is the lowest value the byte
+// can be shrunk to. Pending indicates that shrink of the last value was returned and it's
+// result is yet to be evaluated.
+type bytesState struct {
+	last    []byte
+	size    int
+	index   int
+	low     byte
+	pending bool
+}
+
+// Bytes is a shrinker for byte slices, that shrinks slice's value directly instead of
+// shrinking each of it's bytes as a separate element. Chunks of bytes are removed first,
+// starting with the chunk of half of slice's length and halving it down to a single byte,
+// while slice's length stays within "limits". Then each of the remaining bytes is shrunk
+// towards 0 using binary search.
+func Bytes(limits constraints.Length) arbitrary.Shrinker {
+	return bytesShrinker(limits, bytesState{})
+}
+
+func bytesShrinker(limits constraints.Length, state bytesState) arbitrary.Shrinker {
+	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		state := state
+		if arb.Value.Kind() != reflect.Slice || arb.Value.Type().Elem().Kind() != reflect.Uint8 {
+			return arbitrary.Arbitrary{}, fmt.Errorf("bytes shrinker cannot shrink %s", arb.Value.Type())
+		}
+
+		switch {
+		case !state.pending:
+			state.last = arb.Value.Bytes()
+			state.size = (len(state.last) + 1) / 2
+		case propertyFailed:
+			state.last = arb.Value.Bytes()
+		case state.size > 0:
+			state.index += state.size
+		default:
+			state.low = state.middle() + 1
+		}
+
+		shrink, ok := state.next(limits)
+		if !ok {
+			arb.Value = reflect.ValueOf(state.last).Convert(arb.Value.Type())
+			arb.Shrinker = nil
+			return arb, nil
+		}
+
+		state.pending = true
+		return arbitrary.Arbitrary{
+			Value:    reflect.ValueOf(shrink).Convert(arb.Value.Type()),
+			Shrinker: bytesShrinker(limits, state),
+		}, nil
+	}
+}
+
+// next advances the state to the next possible shrink of the last value and returns
+// it. False is returned if the last value can't be shrunk further.
+func (state *bytesState) next(limits constraints.Length) ([]byte, bool) {
+	for state.size > 0 {
+		if state.index+state.size <= len(state.last) && uint64(len(state.last)-state.size) >= limits.Min {
+			shrink := make([]byte, 0, len(state.last)-state.size)
+			shrink = append(shrink, state.last[:state.index]...)
+			return append(shrink, state.last[state.index+state.size:]...), true
+		}
+		state.size, state.index = state.size/2, 0
+	}
+
+	for ; state.index < len(state.last); state.index, state.low = state.index+1, 0 {
+		if state.low < state.last[state.index] {
+			shrink := append([]byte{}, state.last...)
+			shrink[state.index] = state.middle()
+			return shrink, true
+		}
+	}
+
+	return nil, false
+}
+
+// middle returns the middle of the range between low and the value of the byte at index.
+func (state *bytesState) middle() byte {
+	return state.low + (state.last[state.index]-state.low)/2
+}
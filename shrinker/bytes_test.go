@@ -0,0 +1,86 @@
+package shrinker
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestBytes(t *testing.T) {
+	// shrinkBytes shrinks arbitrary for as long as failing predicate holds and returns
+	// the last value for which predicate holds and the number of shrinks.
+	shrinkBytes := func(t *testing.T, arb arbitrary.Arbitrary, failing func([]byte) bool) ([]byte, int) {
+		last, shrinks := arb.Value.Bytes(), 0
+		for propertyFailed := true; arb.Shrinker != nil; shrinks++ {
+			var err error
+			if arb, err = arb.Shrinker(arb, propertyFailed); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if propertyFailed = failing(arb.Value.Bytes()); propertyFailed {
+				last = arb.Value.Bytes()
+			}
+		}
+		return last, shrinks
+	}
+
+	testCases := map[string]func(*testing.T){
+		"InvalidType": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf([]int{1, 2})}
+			if _, err := Bytes(constraints.LengthDefault())(arb, true); err == nil {
+				t.Fatalf("Expected error because arb is not a byte slice")
+			}
+		},
+		"ShrinkToEmpty": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf(bytes.Repeat([]byte{255}, 1000))}
+			arb.Shrinker = Bytes(constraints.Length{Min: 0, Max: 1000})
+
+			shrunk, shrinks := shrinkBytes(t, arb, func([]byte) bool { return true })
+			if len(shrunk) != 0 {
+				t.Fatalf("Expected bytes to be shrunk to empty slice. Got: %v", shrunk)
+			}
+			if shrinks > 20 {
+				t.Fatalf("Expected bytes to be shrunk in less than 20 shrinks. Got: %d", shrinks)
+			}
+		},
+		"MinLength": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf([]byte{1, 2, 3, 4, 5, 6, 7})}
+			arb.Shrinker = Bytes(constraints.Length{Min: 2, Max: 10})
+
+			shrunk, _ := shrinkBytes(t, arb, func([]byte) bool { return true })
+			if !bytes.Equal(shrunk, []byte{0, 0}) {
+				t.Fatalf("Expected bytes to be shrunk to: [0 0]. Got: %v", shrunk)
+			}
+		},
+		"ShrinkBytes": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf([]byte("hello, world"))}
+			arb.Shrinker = Bytes(constraints.LengthDefault())
+
+			shrunk, _ := shrinkBytes(t, arb, func(data []byte) bool {
+				return bytes.Contains(data, []byte("o,"))
+			})
+			if string(shrunk) != "o," {
+				t.Fatalf("Expected bytes to be shrunk to: %q. Got: %q", "o,", shrunk)
+			}
+		},
+		"NamedType": func(t *testing.T) {
+			type payload []byte
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf(payload{10, 20})}
+			arb.Shrinker = Bytes(constraints.LengthDefault())
+
+			shrink, err := arb.Shrinker(arb, true)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrink.Value.Type() != reflect.TypeOf(payload{}) {
+				t.Fatalf("Expected shrink of type payload. Got: %s", shrink.Value.Type())
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
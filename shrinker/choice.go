@@ -0,0 +1,82 @@
+package shrinker
+
+import (
+	"fmt"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+// Alternative returns arbitrary generated by alternative with specified index.
+type Alternative func(index int) (arbitrary.Arbitrary, error)
+
+// Choice is a shrinker for arbitrary created by choosing one of the alternatives. Index
+// parameter is the index of chosen alternative. Alternatives with lower index are considered
+// simpler, and shrinking first tries to replace chosen alternative with simpler ones (starting
+// from the one with index 0), which are generated on demand by alternative parameter. Once there
+// are no simpler alternatives that falsify the property, chosen alternative is shrunk using it's
+// own shrinker. Alternatives that fail to generate a value are skipped (e.g. with
+// [arbitrary.ErrorInvalidTarget] when they can't generate values of chosen alternative's type),
+// as shrinking can proceed with the remaining ones. Arbitrary shrunk by Choice must have chosen
+// alternative as it's only precursor.
+func Choice(index int, alternative Alternative) arbitrary.Shrinker {
+	if alternative == nil {
+		return Fail(fmt.Errorf("alternative is nil"))
+	}
+	return choiceAlternative(index, 0, alternative)
+}
+
+// choiceAlternative tries to replace chosen alternative (index) with alternative at
+// candidate index. If property is not falsified by it, next alternative is tried.
+func choiceAlternative(index, candidate int, alternative Alternative) arbitrary.Shrinker {
+	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		if candidate >= index {
+			return choiceChosen()(arb, propertyFailed)
+		}
+
+		chosen, err := alternative(candidate)
+		if err != nil {
+			return choiceAlternative(index, candidate+1, alternative)(arb, propertyFailed)
+		}
+
+		revert := func(arbitrary.Arbitrary) arbitrary.Arbitrary {
+			return arb
+		}
+
+		shrink := arbitrary.Arbitrary{
+			Value:      chosen.Value,
+			Precursors: arbitrary.Arbitraries{chosen},
+			Table:      chosen.Table,
+		}
+		shrink.Shrinker = choiceAlternative(candidate, 0, alternative).
+			Or(choiceAlternative(index, candidate+1, alternative).TransformOnceBefore(revert))
+
+		return shrink, nil
+	}
+}
+
+// choiceChosen shrinks the chosen alternative using it's own shrinker.
+func choiceChosen() arbitrary.Shrinker {
+	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		if len(arb.Precursors) != 1 {
+			return arbitrary.Arbitrary{}, fmt.Errorf("choice arbitrary must have exactly 1 precursor. Got: %d", len(arb.Precursors))
+		}
+
+		chosen := arb.Precursors[0]
+		if chosen.Shrinker == nil {
+			arb.Shrinker = nil
+			return arb, nil
+		}
+
+		shrink, err := chosen.Shrinker(chosen, propertyFailed)
+		if err != nil {
+			return arbitrary.Arbitrary{}, err
+		}
+
+		return arbitrary.Arbitrary{
+			Value:      shrink.Value,
+			Precursors: arbitrary.Arbitraries{shrink},
+			Shrinker:   choiceChosen(),
+			Table:      shrink.Table,
+		}, nil
+	}
+}
@@ -0,0 +1,115 @@
+package shrinker
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestChoice(t *testing.T) {
+	// Alternative with index i generates 100*i, and shrinks in range [100*i, 100*i+99]
+	alternative := func(index int) (arbitrary.Arbitrary, error) {
+		return arbitrary.Arbitrary{
+			Value: reflect.ValueOf(uint64(100*index + 99)),
+			Shrinker: Uint64(constraints.Uint64{
+				Min: uint64(100 * index),
+				Max: uint64(100*index + 99),
+			}),
+		}, nil
+	}
+
+	choice := func(index int) arbitrary.Arbitrary {
+		chosen, _ := alternative(index)
+		return arbitrary.Arbitrary{
+			Value:      chosen.Value,
+			Precursors: arbitrary.Arbitraries{chosen},
+			Shrinker:   Choice(index, alternative),
+		}
+	}
+
+	shrink := func(arb arbitrary.Arbitrary, failing func(uint64) bool) (arbitrary.Arbitrary, error) {
+		last := arb
+		for propertyFailed := true; arb.Shrinker != nil; {
+			var err error
+			if arb, err = arb.Shrinker(arb, propertyFailed); err != nil {
+				return arbitrary.Arbitrary{}, err
+			}
+			if propertyFailed = failing(arb.Value.Uint()); propertyFailed {
+				last = arb
+			}
+		}
+		return last, nil
+	}
+
+	testCases := map[string]func(*testing.T){
+		"AlternativeIsNil": func(t *testing.T) {
+			if _, err := Choice(0, nil)(choice(0), true); err == nil {
+				t.Fatalf("Expected error because alternative is nil")
+			}
+		},
+		"SkipFailingAlternatives": func(t *testing.T) {
+			arb := choice(2)
+			arb.Shrinker = Choice(2, func(int) (arbitrary.Arbitrary, error) {
+				return arbitrary.Arbitrary{}, fmt.Errorf("alternative error")
+			})
+
+			shrunk, err := shrink(arb, func(uint64) bool {
+				return true
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrunk.Value.Uint() != 200 {
+				t.Fatalf("Expected chosen alternative to be shrunk to 200. Got: %d", shrunk.Value.Uint())
+			}
+		},
+		"SkipInvalidTargetAlternative": func(t *testing.T) {
+			arb := choice(2)
+			arb.Shrinker = Choice(2, func(index int) (arbitrary.Arbitrary, error) {
+				if index == 0 {
+					return arbitrary.Arbitrary{}, arbitrary.NewErrorInvalidTarget(reflect.TypeOf(""), "Alternative")
+				}
+				return alternative(index)
+			})
+
+			shrunk, err := shrink(arb, func(uint64) bool {
+				return true
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrunk.Value.Uint() != 100 {
+				t.Fatalf("Expected value to be shrunk to 100. Got: %d", shrunk.Value.Uint())
+			}
+		},
+		"ShrinkToSimplestAlternative": func(t *testing.T) {
+			shrunk, err := shrink(choice(3), func(uint64) bool {
+				return true
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrunk.Value.Uint() != 0 {
+				t.Fatalf("Expected value to be shrunk to 0. Got: %d", shrunk.Value.Uint())
+			}
+		},
+		"ShrinkWithinChosenAlternative": func(t *testing.T) {
+			shrunk, err := shrink(choice(3), func(n uint64) bool {
+				return n >= 250
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrunk.Value.Uint() != 250 {
+				t.Fatalf("Expected value to be shrunk to 250. Got: %d", shrunk.Value.Uint())
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
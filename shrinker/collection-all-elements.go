@@ -4,7 +4,7 @@ import "github.com/steffnova/go-check/arbitrary"
 
 func CollectionAllElements() arbitrary.Shrinker {
 	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
-		arb = arb.Copy()
+		arb = copyElements(arb)
 		canShrink := false
 
 		for index, element := range arb.Elements {
@@ -4,7 +4,7 @@ import "github.com/steffnova/go-check/arbitrary"
 
 func CollectionElements(original arbitrary.Arbitrary) arbitrary.Shrinker {
 	transform := func(input arbitrary.Arbitrary) arbitrary.Arbitrary {
-		input = input.Copy()
+		input = copyElements(input)
 		for index, element := range original.Elements {
 			input.Elements[index].Shrinker = element.Shrinker
 		}
@@ -17,3 +17,11 @@ func CollectionElements(original arbitrary.Arbitrary) arbitrary.Shrinker {
 		CollectionAllElements().TransformOnceBefore(transform),
 	)
 }
+
+// copyElements returns arbitrary with a copy of it's elements slice, so that elements can
+// be replaced without affecting the original. Elements themselves are not copied, as
+// shrinkers never modify nested elements in place.
+func copyElements(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+	arb.Elements = append(arbitrary.Arbitraries(nil), arb.Elements...)
+	return arb
+}
@@ -6,7 +6,7 @@ import (
 
 func CollectionOneElement() arbitrary.Shrinker {
 	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
-		arb = arb.Copy()
+		arb = copyElements(arb)
 		for index, element := range arb.Elements {
 			if element.Shrinker == nil {
 				continue
@@ -0,0 +1,60 @@
+package shrinker
+
+import (
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// runsState is the state of runs shrinking. Last are the elements of the last value that
+// falsified the property. Run of elements of the size at index is removed next. Pending
+// indicates that shrink of the last value was returned and it's result is yet to be
+// evaluated.
+type runsState struct {
+	last    arbitrary.Arbitraries
+	size    int
+	index   int
+	pending bool
+}
+
+// CollectionRuns is a shrinker for collections that removes runs of consecutive elements,
+// starting with runs of half of collection's size and halving it down to runs of 2 elements,
+// while collection's size stays within "limits". Single elements are not removed, as they
+// are removed by [Collection] shrinker.
+func CollectionRuns(limits constraints.Length) arbitrary.Shrinker {
+	return collectionRuns(limits, runsState{})
+}
+
+func collectionRuns(limits constraints.Length, state runsState) arbitrary.Shrinker {
+	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		state := state
+		switch {
+		case !state.pending:
+			state.last = arb.Elements
+			state.size = len(state.last) / 2
+		case propertyFailed:
+			state.last = arb.Elements
+		default:
+			state.index += state.size
+		}
+
+		for ; state.size >= 2; state.size, state.index = state.size/2, 0 {
+			if state.index+state.size <= len(state.last) && uint64(len(state.last)-state.size) >= limits.Min {
+				break
+			}
+		}
+		if state.size < 2 {
+			arb.Elements = state.last
+			arb.Shrinker = nil
+			return arb, nil
+		}
+
+		shrink := arb
+		shrink.Elements = make(arbitrary.Arbitraries, 0, len(state.last)-state.size)
+		shrink.Elements = append(shrink.Elements, state.last[:state.index]...)
+		shrink.Elements = append(shrink.Elements, state.last[state.index+state.size:]...)
+
+		state.pending = true
+		shrink.Shrinker = collectionRuns(limits, state)
+		return shrink, nil
+	}
+}
@@ -0,0 +1,99 @@
+package shrinker
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestCollectionRuns(t *testing.T) {
+	// shrinkRuns shrinks arbitrary whose elements are uint64 values for as long as failing
+	// predicate holds and returns the values of the last elements for which predicate holds.
+	shrinkRuns := func(t *testing.T, arb arbitrary.Arbitrary, failing func([]uint64) bool) []uint64 {
+		values := func(arb arbitrary.Arbitrary) []uint64 {
+			data := make([]uint64, len(arb.Elements))
+			for index, element := range arb.Elements {
+				data[index] = element.Value.Uint()
+			}
+			return data
+		}
+
+		last := values(arb)
+		for propertyFailed := true; arb.Shrinker != nil; {
+			var err error
+			if arb, err = arb.Shrinker(arb, propertyFailed); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if propertyFailed = failing(values(arb)); propertyFailed {
+				last = values(arb)
+			}
+		}
+		return last
+	}
+
+	elements := func(n int) arbitrary.Arbitraries {
+		elements := make(arbitrary.Arbitraries, n)
+		for index := range elements {
+			elements[index] = arbitrary.Arbitrary{Value: reflect.ValueOf(uint64(index))}
+		}
+		return elements
+	}
+
+	testCases := map[string]func(*testing.T){
+		"RemoveHalf": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Elements: elements(10)}
+
+			shrink, err := CollectionRuns(constraints.LengthDefault())(arb, true)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(shrink.Elements, arb.Elements[5:]) {
+				t.Fatalf("Expected the first half of elements to be removed")
+			}
+		},
+		"RemoveRun": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Elements: elements(16)}
+			arb.Shrinker = CollectionRuns(constraints.LengthDefault())
+
+			shrunk := shrinkRuns(t, arb, func(values []uint64) bool {
+				for _, value := range values {
+					if value == 6 {
+						return true
+					}
+				}
+				return false
+			})
+
+			if !reflect.DeepEqual(shrunk, []uint64{6, 7}) {
+				t.Fatalf("Expected elements to be shrunk to [6 7]. Got: %v", shrunk)
+			}
+		},
+		"MinLength": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Elements: elements(10)}
+			arb.Shrinker = CollectionRuns(constraints.Length{Min: 7, Max: 10})
+
+			shrunk := shrinkRuns(t, arb, func([]uint64) bool { return true })
+			if len(shrunk) != 8 {
+				t.Fatalf("Expected elements to be shrunk to 8 elements. Got: %v", shrunk)
+			}
+		},
+		"SingleElement": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Elements: elements(1)}
+			arb.Shrinker = CollectionRuns(constraints.LengthDefault())
+
+			shrink, err := arb.Shrinker(arb, true)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrink.Shrinker != nil || len(shrink.Elements) != 1 {
+				t.Fatalf("Expected shrinking to finish without removing the element")
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
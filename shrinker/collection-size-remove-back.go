@@ -11,19 +11,16 @@ func CollectionSizeRemoveBack(index int) arbitrary.Shrinker {
 		switch {
 		case index >= len(arb.Elements):
 			return arbitrary.Arbitrary{}, fmt.Errorf("index is out of range")
-		case index < 0 && propertyFailed:
-			reduced := arb.Copy()
-			reduced.Shrinker = nil
-			reduced.Shrinker = CollectionElements(reduced)
-			return reduced, nil
+		case index < 0:
+			arb.Shrinker = CollectionElements(arb)
+			return arb, nil
 		default:
-			reduced := arb.Copy()
+			reduced := arb
 			elements := []arbitrary.Arbitrary{}
 			elements = append(elements, arb.Elements[:index]...)
 			elements = append(elements, arb.Elements[index+1:]...)
 
 			revertRemoval := func(in arbitrary.Arbitrary) arbitrary.Arbitrary {
-				in = in.Copy()
 				in.Elements = arb.Elements
 				return in
 			}
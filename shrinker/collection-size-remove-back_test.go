@@ -127,6 +127,19 @@ func TestCollectionSizeRemoveBack(t *testing.T) {
 				t.Fatalf("Expected element value to be 3, got %d", arb.Elements[0].Value.Uint())
 			}
 		},
+		"EmptyCollectionPropertyPassed": func(t *testing.T) {
+			// Shrinker of an empty collection can be called with propertyFailed set to
+			// false, when it follows another shrinker in a Chain.
+			arb := arbitrary.Arbitrary{Shrinker: CollectionSizeRemoveBack(-1)}
+
+			shrink, err := arb.Shrinker(arb, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(shrink.Elements) != 0 {
+				t.Fatalf("Expected empty collection")
+			}
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -11,12 +11,11 @@ func CollectionSizeRemoveFront(index int) arbitrary.Shrinker {
 		switch {
 		case index < 0:
 			return arbitrary.Arbitrary{}, fmt.Errorf("index is out of range")
-		case index >= len(arb.Elements) && propertyFailed:
-			reduced := arb.Copy()
-			reduced.Shrinker = CollectionElements(reduced)
-			return reduced, nil
+		case index >= len(arb.Elements):
+			arb.Shrinker = CollectionElements(arb)
+			return arb, nil
 		default:
-			reduced := arb.Copy()
+			reduced := arb
 			elements := []arbitrary.Arbitrary{}
 			elements = append(elements, arb.Elements[:index]...)
 			elements = append(elements, arb.Elements[index+1:]...)
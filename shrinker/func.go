@@ -0,0 +1,25 @@
+package shrinker
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+// Func is a shrinker for functions defined by a finite input→output table (see
+// [arbitrary.NewFunc]). Function shrinking consists of shrinking the table, by removing
+// it's entries and shrinking their outputs, and shrinking the outputs returned for the
+// inputs that are not in the table. Error is returned if function type is not func or
+// the number of function arbitrary's elements is not 2.
+func Func(original arbitrary.Arbitrary) arbitrary.Shrinker {
+	switch {
+	case original.Value.Kind() != reflect.Func:
+		return Fail(fmt.Errorf("func shrinker cannot shrink %s", original.Value.Kind().String()))
+	case len(original.Elements) != 2:
+		return Fail(fmt.Errorf("number of func arbitraries %d must be 2", len(original.Elements)))
+	default:
+		return CollectionElements(original).
+			TransformAfter(arbitrary.NewFunc(original.Value.Type()))
+	}
+}
@@ -0,0 +1,77 @@
+package shrinker
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestFunc(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"OriginalNotAFunc": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf(10)}
+			shrinker := Func(arb)
+			if _, err := shrinker(arb, true); err == nil {
+				t.Fatalf("Expected error when original arbitrary is not a func")
+			}
+		},
+		"OriginalInsufficientElements": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf(func(uint64) uint64 { return 0 })}
+			shrinker := Func(arb)
+			if _, err := shrinker(arb, true); err == nil {
+				t.Fatalf("Expected error when original arbitrary doesn't have 2 elements")
+			}
+		},
+		"ShrinkingFinishes": func(t *testing.T) {
+			output := func(n uint64) arbitrary.Arbitrary {
+				return arbitrary.Arbitrary{
+					Value:    reflect.ValueOf(n),
+					Shrinker: Uint64(constraints.Uint64Default()),
+				}
+			}
+
+			entries := make(arbitrary.Arbitraries, 3)
+			for index := range entries {
+				entries[index] = arbitrary.Arbitrary{
+					Precursors: arbitrary.Arbitraries{{Value: reflect.ValueOf(uint64(index))}},
+					Elements:   arbitrary.Arbitraries{output(uint64(index) + 10)},
+				}
+				entries[index].Shrinker = CollectionElements(entries[index])
+			}
+			table := arbitrary.Arbitrary{Elements: entries, Shrinker: Collection()}
+			defaults := arbitrary.Arbitrary{Elements: arbitrary.Arbitraries{output(100)}}
+			defaults.Shrinker = CollectionElements(defaults)
+
+			arb := arbitrary.NewFunc(reflect.TypeOf(func(uint64) uint64 { return 0 }))(arbitrary.Arbitrary{
+				Elements: arbitrary.Arbitraries{table, defaults},
+			})
+			if fn := arb.Value.Interface().(func(uint64) uint64); fn(1) != 11 || fn(5) != 100 {
+				t.Fatalf("Expected function defined by the table")
+			}
+			arb.Shrinker = Func(arb)
+
+			// Property fails as long as function returns value greater than 5 for input 1
+			last := arb
+			for propertyFailed := true; arb.Shrinker != nil; {
+				var err error
+				if arb, err = arb.Shrinker(arb, propertyFailed); err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if propertyFailed = arb.Value.Interface().(func(uint64) uint64)(1) > 5; propertyFailed {
+					last = arb
+				}
+			}
+
+			expected := "<func(uint64) uint64> func{_ -> 6}"
+			if encoded := arbitrary.EncodeArbitraryToString(last); encoded != expected {
+				t.Fatalf("Expected function to be shrunk to %s. Got: %s", expected, encoded)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/steffnova/go-check/constraints"
 )
 
+// Map is a shrinker for maps. Map's key-value pairs are shrunk in three phases. First, subsets
+// of keys are removed, then only values are shrunk and lastly keys are shrunk. Shrinks whose
+// size is not within "con" limits, or whose keys collide are skipped.
 func Map(original arbitrary.Arbitrary, con constraints.Length) arbitrary.Shrinker {
 	switch {
 	case original.Value.Kind() != reflect.Map:
@@ -15,14 +18,102 @@ func Map(original arbitrary.Arbitrary, con constraints.Length) arbitrary.Shrinke
 	case original.Value.Len() != len(original.Elements):
 		return Fail(fmt.Errorf("number of map's key-value pairs %d must match size of the map %d", len(original.Elements), original.Value.Len()))
 	default:
-
 		filter := arbitrary.FilterPredicate(original.Value.Type(), func(in reflect.Value) bool {
-			return in.Len() >= int(con.Min)
+			return in.Len() >= int(con.Min) && in.Len() <= int(con.Max)
 		})
 
-		return Collection().
+		removal := func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+			shrinker := Chain(
+				CollectionSizeRemoveBack(len(arb.Elements)-1),
+				CollectionSizeRemoveFront(0),
+			)
+			return shrinker(arb, propertyFailed)
+		}
+
+		shrinker := Chain(
+			Chain(CollectionRuns(con), removal).TransformOnceBefore(mapEntries(-1)),
+			MapEntries(1),
+			MapEntries(0),
+		)
+
+		return mapDistinct(shrinker.
 			Validate(arbitrary.ValidateMap()).
 			TransformAfter(arbitrary.NewMap(original.Value.Type())).
-			Filter(filter)
+			Filter(filter), original)
+	}
+}
+
+// MapEntries is a shrinker for map's key-value pairs that shrinks only the key (index 0) or
+// only the value (index 1) of each pair.
+func MapEntries(index int) arbitrary.Shrinker {
+	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		arb = mapEntries(index)(arb)
+		return CollectionElements(arb)(arb, propertyFailed)
+	}
+}
+
+// mapEntries returns a transform that sets shrinker of each key-value pair to a shrinker of
+// pair's element at index. Pairs are not shrunk if index is out of the pair's range.
+func mapEntries(index int) func(arbitrary.Arbitrary) arbitrary.Arbitrary {
+	return func(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+		arb = copyElements(arb)
+		for i, entry := range arb.Elements {
+			arb.Elements[i].Shrinker = nil
+			if index >= 0 && index < len(entry.Elements) && entry.Elements[index].Shrinker != nil {
+				arb.Elements[i].Shrinker = mapEntry(index)
+			}
+		}
+		return arb
+	}
+}
+
+func mapEntry(index int) arbitrary.Shrinker {
+	return func(entry arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		element := entry.Elements[index]
+		if element.Shrinker == nil {
+			entry.Shrinker = nil
+			return entry, nil
+		}
+
+		shrink, err := element.Shrinker(element, propertyFailed)
+		if err != nil {
+			return arbitrary.Arbitrary{}, err
+		}
+
+		entry = copyElements(entry)
+		entry.Elements[index] = shrink
+		entry.Shrinker = nil
+		if shrink.Shrinker != nil {
+			entry.Shrinker = mapEntry(index)
+		}
+		return entry, nil
+	}
+}
+
+// mapDistinct skips shrinks in which keys of two or more key-value pairs are equal, as such
+// shrinks result in a map with less pairs than there are elements. If shrinking ends with such
+// shrink, last arbitrary for which property failed is returned instead.
+func mapDistinct(shrinker arbitrary.Shrinker, last arbitrary.Arbitrary) arbitrary.Shrinker {
+	if shrinker == nil {
+		return nil
+	}
+	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		if propertyFailed {
+			last = arb
+		}
+
+		shrink, err := shrinker(arb, propertyFailed)
+		switch {
+		case err != nil:
+			return arbitrary.Arbitrary{}, err
+		case shrink.Value.Len() == len(shrink.Elements):
+			shrink.Shrinker = mapDistinct(shrink.Shrinker, last)
+			return shrink, nil
+		case shrink.Shrinker == nil:
+			last.Shrinker = nil
+			return last, nil
+		default:
+			return mapDistinct(shrink.Shrinker, last)(shrink, false)
+		}
 	}
 }
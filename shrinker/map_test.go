@@ -80,6 +80,71 @@ func TestMap(t *testing.T) {
 				t.Fatal("Shrunk value for key 5 should be 0")
 			}
 		},
+		"SkipCollidingKeys": func(t *testing.T) {
+			m := map[uint64]uint64{7: 7, 9: 9}
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf(m)}
+
+			for _, key := range []uint64{7, 9} {
+				arb.Elements = append(arb.Elements, arbitrary.Arbitrary{
+					Elements: arbitrary.Arbitraries{
+						{Value: reflect.ValueOf(key), Shrinker: Uint64(constraints.Uint64Default())},
+						{Value: reflect.ValueOf(m[key]), Shrinker: Uint64(constraints.Uint64Default())},
+					},
+				})
+			}
+
+			arb.Shrinker = Map(arb, constraints.Length{Min: 2, Max: 2})
+
+			for propertyFailed := true; arb.Shrinker != nil; {
+				var err error
+				if arb, err = arb.Shrinker(arb, propertyFailed); err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if arb.Value.Len() != len(arb.Elements) {
+					t.Fatalf("Shrink %v has colliding keys", arb.Value)
+				}
+				propertyFailed = true
+			}
+
+			result := arb.Value.Interface().(map[uint64]uint64)
+			if expected := (map[uint64]uint64{0: 0, 1: 0}); !reflect.DeepEqual(result, expected) {
+				t.Fatalf("Expected shrunk map %v, got: %v", expected, result)
+			}
+		},
+		"CollidingLastShrink": func(t *testing.T) {
+			// Map fails only with 2 pairs, and the last shrink (key 1 to 0) collides with
+			// the other key, in which case the last valid map is returned.
+			m := map[uint64]uint64{0: 0, 1: 0}
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf(m)}
+			toZero := func(arbitrary.Arbitrary, bool) (arbitrary.Arbitrary, error) {
+				return arbitrary.Arbitrary{Value: reflect.ValueOf(uint64(0))}, nil
+			}
+
+			for _, key := range []uint64{0, 1} {
+				arb.Elements = append(arb.Elements, arbitrary.Arbitrary{
+					Elements: arbitrary.Arbitraries{
+						{Value: reflect.ValueOf(key), Shrinker: toZero},
+						{Value: reflect.ValueOf(m[key])},
+					},
+				})
+			}
+
+			arb.Shrinker = Map(arb, constraints.LengthDefault())
+
+			for propertyFailed := true; arb.Shrinker != nil; propertyFailed = arb.Value.Len() == 2 {
+				var err error
+				if arb, err = arb.Shrinker(arb, propertyFailed); err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+			}
+
+			if arb.Value.Len() != len(arb.Elements) {
+				t.Fatalf("Shrink %v has colliding keys", arb.Value)
+			}
+			if result := arb.Value.Interface().(map[uint64]uint64); !reflect.DeepEqual(result, m) {
+				t.Fatalf("Expected shrunk map %v, got: %v", m, result)
+			}
+		},
 	}
 
 	for name, testCase := range testCases {
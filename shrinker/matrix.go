@@ -0,0 +1,90 @@
+package shrinker
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+// Matrix is a shrinker for matrices (slice of slices, [][]T) whose rows are all of the same
+// length. Arbitrary's elements are matrix rows and row's elements are matrix cells. Matrix is
+// shrunk by removing rows, then by removing columns and finally by shrinking it's cells. Shrinks
+// with number of rows or columns lower than rows.Min or cols.Min respectively are skipped.
+// Error is returned if original's value is not a slice of slices or number of rows doesn't
+// match number of elements.
+func Matrix(original arbitrary.Arbitrary, rows, cols constraints.Length) arbitrary.Shrinker {
+	switch {
+	case original.Value.Kind() != reflect.Slice || original.Value.Type().Elem().Kind() != reflect.Slice:
+		return Fail(fmt.Errorf("matrix shrinker cannot shrink %s", original.Value.Type()))
+	case original.Value.Len() != len(original.Elements):
+		return Fail(fmt.Errorf("number of rows %d must match size of the matrix %d", len(original.Elements), original.Value.Len()))
+	default:
+		filter := arbitrary.FilterPredicate(original.Value.Type(), func(in reflect.Value) bool {
+			if in.Len() < int(rows.Min) {
+				return false
+			}
+			return in.Len() == 0 || in.Index(0).Len() >= int(cols.Min)
+		})
+
+		removeLines := func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+			return Chain(
+				CollectionSizeRemoveBack(len(arb.Elements)-1),
+				CollectionSizeRemoveFront(0),
+			)(arb, propertyFailed)
+		}
+
+		removeColumns := func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+			return arbitrary.Shrinker(removeLines).
+				TransformBefore(matrixTranspose).
+				TransformAfter(matrixTranspose)(arb, propertyFailed)
+		}
+
+		shrinkCells := func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+			return CollectionElements(arb)(arb, propertyFailed)
+		}
+
+		return Chain(removeLines, removeColumns, shrinkCells).
+			TransformAfter(matrixNew(original.Value.Type())).
+			Filter(filter)
+	}
+}
+
+// matrixTranspose returns arbitrary whose elements are columns of the matrix arbitrary.
+// Line (row or column) can be shrunk only if any of it's cells can be shrunk.
+func matrixTranspose(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+	if len(arb.Elements) == 0 {
+		return arb
+	}
+
+	lines := make(arbitrary.Arbitraries, len(arb.Elements[0].Elements))
+	for index := range lines {
+		cells := make(arbitrary.Arbitraries, len(arb.Elements))
+		for line, element := range arb.Elements {
+			cells[line] = element.Elements[index]
+		}
+		lines[index] = arbitrary.Arbitrary{Elements: cells}
+		for _, cell := range cells {
+			if cell.Shrinker != nil {
+				lines[index].Shrinker = CollectionElements(lines[index])
+				break
+			}
+		}
+	}
+
+	arb.Elements = lines
+	return arb
+}
+
+// matrixNew returns transform that sets values of matrix arbitrary and it's rows.
+func matrixNew(t reflect.Type) func(arbitrary.Arbitrary) arbitrary.Arbitrary {
+	return func(arb arbitrary.Arbitrary) arbitrary.Arbitrary {
+		rows := make(arbitrary.Arbitraries, len(arb.Elements))
+		for index, row := range arb.Elements {
+			rows[index] = arbitrary.NewSlice(t.Elem())(row)
+		}
+		arb.Elements = rows
+		return arbitrary.NewSlice(t)(arb)
+	}
+}
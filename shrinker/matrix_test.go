@@ -0,0 +1,91 @@
+package shrinker
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestMatrix(t *testing.T) {
+	matrix := func(values [][]uint64) arbitrary.Arbitrary {
+		rows := make(arbitrary.Arbitraries, len(values))
+		for index, row := range values {
+			cells := make(arbitrary.Arbitraries, len(row))
+			for col, value := range row {
+				cells[col] = arbitrary.Arbitrary{
+					Value:    reflect.ValueOf(value),
+					Shrinker: Uint64(constraints.Uint64Default()),
+				}
+			}
+			rows[index] = arbitrary.Arbitrary{
+				Value:    reflect.ValueOf(row),
+				Elements: cells,
+			}
+			rows[index].Shrinker = CollectionElements(rows[index])
+		}
+		return arbitrary.Arbitrary{
+			Value:    reflect.ValueOf(values),
+			Elements: rows,
+		}
+	}
+
+	testCases := map[string]func(t *testing.T){
+		"InvalidOriginal": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf([]uint64{1, 2})}
+			if _, err := Matrix(arb, constraints.LengthDefault(), constraints.LengthDefault())(arb, true); err == nil {
+				t.Fatalf("Expected error when original arbitrary is not slice of slices")
+			}
+		},
+		"InvalidElements": func(t *testing.T) {
+			arb := arbitrary.Arbitrary{Value: reflect.ValueOf([][]uint64{{1, 2}})}
+			if _, err := Matrix(arb, constraints.LengthDefault(), constraints.LengthDefault())(arb, true); err == nil {
+				t.Fatalf("Expected error when number of arbitrary elements doesn't match number of rows")
+			}
+		},
+		"Shrink": func(t *testing.T) {
+			arb := matrix([][]uint64{
+				{1, 2, 3, 4},
+				{5, 6, 7, 8},
+				{9, 10, 11, 12},
+			})
+			arb.Shrinker = Matrix(arb, constraints.Length{Min: 1, Max: 3}, constraints.Length{Min: 1, Max: 4})
+
+			// Property fails if matrix contains a value greater than 6 in a column
+			// that is not the first one.
+			property := func(in [][]uint64) bool {
+				for _, row := range in {
+					if len(row) != len(in[0]) {
+						t.Fatalf("Rows have different lengths: %v", in)
+					}
+					for _, value := range row[1:] {
+						if value > 6 {
+							return true
+						}
+					}
+				}
+				return false
+			}
+
+			last := arb.Value
+			for propertyFailed := true; arb.Shrinker != nil; {
+				var err error
+				if arb, err = arb.Shrinker(arb, propertyFailed); err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if propertyFailed = property(arb.Value.Interface().([][]uint64)); propertyFailed {
+					last = arb.Value
+				}
+			}
+
+			if expected := [][]uint64{{0, 7}}; !reflect.DeepEqual(last.Interface(), expected) {
+				t.Fatalf("Expected matrix to be shrunk to: %v. Got: %v", expected, last)
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
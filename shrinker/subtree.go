@@ -0,0 +1,133 @@
+package shrinker
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/steffnova/go-check/arbitrary"
+)
+
+// Subtree is a shrinker that tries to replace arbitrary with one of it's subtrees. Subtrees
+// are arbitraries nested in arbitrary's elements and precursors (at any level) for which
+// isSubtree returns true and whose value is of the same type as arbitrary's value. They are
+// tried in pre-order, starting with the ones closest to the arbitrary. Subtrees whose value
+// is a pointer that is already the value of the arbitrary or of a previous subtree (e.g. when
+// pointers alias each other) are skipped, as they are not simpler. Once subtree that
+// falsifies the property is found, shrinking continues with subtree's own shrinker. Subtrees
+// that contain pointers which alias pointers outside of the subtree (e.g. back-references in
+// cyclic values) are skipped as well, as values those pointers point to can't be shrunk once
+// arbitrary is replaced with the subtree. Pointer is an alias if neither it's arbitrary, nor
+// any of it's first precursors with the same pointer, has elements or precursors of other
+// values. If none of the subtrees falsifies the property, arbitrary is shrunk by next shrinker.
+func Subtree(isSubtree func(arbitrary.Arbitrary) bool, next arbitrary.Shrinker) arbitrary.Shrinker {
+	if isSubtree == nil {
+		return Fail(fmt.Errorf("subtree predicate is nil"))
+	}
+
+	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		subtrees := arbitrary.Arbitraries{}
+		pointers := map[uintptr]bool{}
+		if arb.Value.Kind() == reflect.Ptr {
+			pointers[arb.Value.Pointer()] = true
+		}
+
+		var collect func(arbitrary.Arbitraries)
+		collect = func(arbs arbitrary.Arbitraries) {
+			for _, element := range arbs {
+				if element.Value.IsValid() && element.Value.Type() == arb.Value.Type() && isSubtree(element) {
+					if element.Value.Kind() != reflect.Ptr || element.Value.IsNil() || !pointers[element.Value.Pointer()] && closed(element) {
+						subtrees = append(subtrees, element)
+					}
+					if element.Value.Kind() == reflect.Ptr && !element.Value.IsNil() {
+						pointers[element.Value.Pointer()] = true
+					}
+				}
+				collect(element.Elements)
+				collect(element.Precursors)
+			}
+		}
+		collect(arb.Elements)
+		collect(arb.Precursors)
+
+		return subtree(subtrees, 0, next)(arb, propertyFailed)
+	}
+}
+
+// closed returns true if all pointers within arbitrary that are aliases (see [Subtree]) alias
+// pointers within the arbitrary.
+func closed(arb arbitrary.Arbitrary) bool {
+	type key struct {
+		t       reflect.Type
+		address uintptr
+	}
+	owned, aliases := map[key]bool{}, map[key]bool{}
+
+	var visit func(arbitrary.Arbitrary)
+	visit = func(arb arbitrary.Arbitrary) {
+		if arb.Value.Kind() == reflect.Ptr && !arb.Value.IsNil() {
+			pointer := key{t: arb.Value.Type(), address: arb.Value.Pointer()}
+			if alias(arb) {
+				aliases[pointer] = true
+			} else {
+				owned[pointer] = true
+			}
+		}
+		for _, element := range arb.Elements {
+			visit(element)
+		}
+		for _, precursor := range arb.Precursors {
+			visit(precursor)
+		}
+	}
+	visit(arb)
+
+	for pointer := range aliases {
+		if !owned[pointer] {
+			return false
+		}
+	}
+	return true
+}
+
+// alias returns true if arbitrary of a pointer is an alias, as described in [Subtree].
+func alias(arb arbitrary.Arbitrary) bool {
+	for len(arb.Elements) == 0 && len(arb.Precursors) != 0 {
+		precursor := arb.Precursors[0]
+		if !precursor.Value.IsValid() || precursor.Value.Type() != arb.Value.Type() || precursor.Value.Pointer() != arb.Value.Pointer() {
+			return false
+		}
+		arb = precursor
+	}
+	return len(arb.Elements) == 0
+}
+
+// subtree tries to replace arbitrary with subtree at specified index. If property is not
+// falsified by it, next subtree is tried.
+func subtree(subtrees arbitrary.Arbitraries, index int, next arbitrary.Shrinker) arbitrary.Shrinker {
+	return func(arb arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		if index >= len(subtrees) {
+			if next == nil {
+				arb.Shrinker = nil
+				return arb, nil
+			}
+			return next(arb, propertyFailed)
+		}
+
+		revert := func(arbitrary.Arbitrary) arbitrary.Arbitrary {
+			return arb
+		}
+
+		accepted := subtrees[index].Shrinker
+		if accepted == nil {
+			accepted = func(arb arbitrary.Arbitrary, _ bool) (arbitrary.Arbitrary, error) {
+				arb.Shrinker = nil
+				return arb, nil
+			}
+		}
+
+		shrink := subtrees[index]
+		shrink.Shrinker = accepted.Or(subtree(subtrees, index+1, next).TransformOnceBefore(revert))
+
+		return shrink, nil
+	}
+}
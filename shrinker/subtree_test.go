@@ -0,0 +1,132 @@
+package shrinker
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steffnova/go-check/arbitrary"
+	"github.com/steffnova/go-check/constraints"
+)
+
+func TestSubtree(t *testing.T) {
+	isSubtree := func(arbitrary.Arbitrary) bool {
+		return true
+	}
+
+	node := func(value uint64, children ...arbitrary.Arbitrary) arbitrary.Arbitrary {
+		return arbitrary.Arbitrary{
+			Value:    reflect.ValueOf(value),
+			Elements: children,
+			Shrinker: Subtree(isSubtree, nil),
+		}
+	}
+
+	// Tree: 10 -> [5 -> [1], 7]
+	tree := func() arbitrary.Arbitrary {
+		return node(10, node(5, node(1)), node(7))
+	}
+
+	shrink := func(arb arbitrary.Arbitrary, failing func(uint64) bool) (arbitrary.Arbitrary, error) {
+		last := arb
+		for propertyFailed := true; arb.Shrinker != nil; {
+			var err error
+			if arb, err = arb.Shrinker(arb, propertyFailed); err != nil {
+				return arbitrary.Arbitrary{}, err
+			}
+			if propertyFailed = failing(arb.Value.Uint()); propertyFailed {
+				last = arb
+			}
+		}
+		return last, nil
+	}
+
+	testCases := map[string]func(*testing.T){
+		"PredicateIsNil": func(t *testing.T) {
+			if _, err := Subtree(nil, nil)(tree(), true); err == nil {
+				t.Fatalf("Expected error because subtree predicate is nil")
+			}
+		},
+		"ShrinkToNestedSubtree": func(t *testing.T) {
+			shrunk, err := shrink(tree(), func(n uint64) bool {
+				return n%2 == 1
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrunk.Value.Uint() != 1 {
+				t.Fatalf("Expected value to be shrunk to 1. Got: %d", shrunk.Value.Uint())
+			}
+		},
+		"SkipSamePointer": func(t *testing.T) {
+			root, other := new(int), new(int)
+			ptr := func(value *int, children ...arbitrary.Arbitrary) arbitrary.Arbitrary {
+				return arbitrary.Arbitrary{Value: reflect.ValueOf(value), Elements: children}
+			}
+
+			value := arbitrary.Arbitrary{Value: reflect.ValueOf(0)}
+
+			arb := ptr(root, ptr(root), ptr(other, value), ptr(other))
+			shrink, err := Subtree(isSubtree, nil)(arb, true)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrink.Value.Pointer() != reflect.ValueOf(other).Pointer() {
+				t.Fatalf("Expected subtree with the same pointer as the root to be skipped")
+			}
+
+			shrink, err = shrink.Shrinker(shrink, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrink.Value.Pointer() != reflect.ValueOf(root).Pointer() || shrink.Shrinker != nil {
+				t.Fatalf("Expected subtree with the same pointer as the previous subtree to be skipped")
+			}
+		},
+		"SkipSubtreeWithOutsideAlias": func(t *testing.T) {
+			root, first, second := new(int), new(int), new(int)
+			ptr := func(value *int, children ...arbitrary.Arbitrary) arbitrary.Arbitrary {
+				return arbitrary.Arbitrary{Value: reflect.ValueOf(value), Elements: children}
+			}
+
+			// First subtree aliases the root (e.g. back-reference in a cycle), while second
+			// subtree aliases only itself.
+			arb := ptr(root, ptr(first, ptr(root)), ptr(second, ptr(second)))
+			shrink, err := Subtree(isSubtree, nil)(arb, true)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrink.Value.Pointer() != reflect.ValueOf(second).Pointer() {
+				t.Fatalf("Expected subtree that aliases pointer outside of it to be skipped")
+			}
+		},
+		"ShrinkToSubtreeInPreOrder": func(t *testing.T) {
+			shrunk, err := shrink(tree(), func(n uint64) bool {
+				return n >= 6
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrunk.Value.Uint() != 7 {
+				t.Fatalf("Expected value to be shrunk to 7. Got: %d", shrunk.Value.Uint())
+			}
+		},
+		"ShrinkWithNextShrinker": func(t *testing.T) {
+			arb := tree()
+			arb.Shrinker = Subtree(isSubtree, Uint64(constraints.Uint64{Min: 8, Max: 10}))
+
+			shrunk, err := shrink(arb, func(n uint64) bool {
+				return n >= 8
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if shrunk.Value.Uint() != 8 {
+				t.Fatalf("Expected value to be shrunk to 8. Got: %d", shrunk.Value.Uint())
+			}
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, testCase)
+	}
+}
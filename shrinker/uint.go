@@ -10,6 +10,8 @@ import (
 
 func Uint64(limits constraints.Uint64) arbitrary.Shrinker {
 	return func(val arbitrary.Arbitrary, propertyFailed bool) (arbitrary.Arbitrary, error) {
+		// Limits are copied, so that shrinker can be used more than once.
+		limits := limits
 		switch {
 		case val.Value.Kind() != reflect.Uint64:
 			return arbitrary.Arbitrary{}, fmt.Errorf("uint64 shrinker cannot shrink %s", val.Value.Kind().String())